@@ -0,0 +1,114 @@
+package base32
+
+import (
+	"errors"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/basen"
+)
+
+var (
+	// StdEncoding crockford base32 standard encoder, decoding is case-insensitive,
+	// and I, L are decoded as 1, O is decoded as 0.
+	StdEncoding = mustNewEncoder(&basen.Config{
+		Source:          StdSource(),
+		CaseInsensitive: true,
+		Aliases:         map[byte]byte{'I': '1', 'L': '1', 'O': '0'},
+		Name:            "base32",
+	})
+
+	// stdSource crockford base32 standard source string.
+	stdSource = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// checkSource crockford base32 extra check symbols for values 32 to 36.
+	checkSource = "*~$=U"
+
+	// ErrOverflow decoding value overflows error.
+	ErrOverflow = basen.ErrOverflow
+	// ErrNegative encoding negative value error.
+	ErrNegative = basen.ErrNegative
+	// ErrChecksum check symbol mismatch error.
+	ErrChecksum = errors.New("base32: check symbol error")
+)
+
+// Encoder base32 encoder.
+type Encoder struct {
+	*basen.Encoder
+	check *basen.Encoder // check symbol encoder, modulo 37
+}
+
+// StdSource returns crockford base32 standard source string.
+func StdSource() string {
+	return stdSource
+}
+
+// MustNewEncoder must new a base32 encoder.
+func MustNewEncoder(source string) *Encoder {
+	enc, err := NewEncoder(source)
+	if err != nil {
+		panic(err)
+	}
+
+	return enc
+}
+
+// NewEncoder new a base32 encoder, decoding is case-insensitive.
+func NewEncoder(source string) (*Encoder, error) {
+	if len(source) != 32 {
+		return nil, errors.New("base32: encoding source is not 32-bytes long")
+	}
+
+	return newEncoder(&basen.Config{Source: source, CaseInsensitive: true, Name: "base32"})
+}
+
+func mustNewEncoder(c *basen.Config) *Encoder {
+	enc, err := newEncoder(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return enc
+}
+
+func newEncoder(c *basen.Config) (*Encoder, error) {
+	enc, err := basen.NewEncoderWithConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	cc := *c
+	cc.Source += checkSource
+	check, err := basen.NewEncoderWithConfig(&cc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Encoder{Encoder: enc, check: check}, nil
+}
+
+// EncodeWithCheck base32 encodes a uint64 id, and appends the crockford check symbol,
+// which is the id modulo 37 encoded by the source and extra symbols *~$=U.
+func (enc *Encoder) EncodeWithCheck(id uint64) string {
+	return enc.EncodeUint64(id) + enc.check.EncodeUint64(id%37)
+}
+
+// DecodeWithCheck base32 decodes a string id with the trailing check symbol,
+// and verifies the check symbol.
+func (enc *Encoder) DecodeWithCheck(id string) (uint64, error) {
+	if len(id) < 2 {
+		return 0, errors.New("base32: decoding id with check symbol should be at least 2-bytes long")
+	}
+
+	n, err := enc.DecodeUint64(id[:len(id)-1])
+	if err != nil {
+		return 0, err
+	}
+
+	c, err := enc.check.DecodeUint64(id[len(id)-1:])
+	if err != nil {
+		return 0, err
+	}
+	if c != n%37 {
+		return 0, ErrChecksum
+	}
+
+	return n, nil
+}
@@ -0,0 +1,89 @@
+package base32
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdSource(t *testing.T) {
+	const expect = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	assert.Equal(t, expect, StdSource())
+}
+
+func TestNewEncoder(t *testing.T) {
+	enc, err := NewEncoder("0123456789abcdefghjkmnpqrstvwxyz")
+	require.NoError(t, err)
+	assert.NotNil(t, enc)
+
+	_, err = NewEncoder("test")
+	require.EqualError(t, err, "base32: encoding source is not 32-bytes long")
+
+	_, err = NewEncoder("00123456789abcdefghjkmnpqrstvwxy")
+	require.EqualError(t, err, "base32: duplicate encoding character - 0")
+
+	assert.PanicsWithError(t, "base32: encoding source is not 32-bytes long", func() {
+		MustNewEncoder("test")
+	})
+}
+
+func TestStdEncoding(t *testing.T) {
+	cases := map[uint64]string{
+		0:              "0",
+		31:             "Z",
+		1234:           "16J",
+		math.MaxUint64: "FZZZZZZZZZZZZ",
+	}
+
+	for k, v := range cases {
+		assert.Equal(t, v, StdEncoding.EncodeUint64(k))
+		d, err := StdEncoding.DecodeUint64(v)
+		require.NoError(t, err)
+		assert.Equal(t, k, d)
+	}
+
+	for _, s := range []string{"16j", "I6J", "l6j"} {
+		d, err := StdEncoding.DecodeUint64(s)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1234), d)
+	}
+
+	d, err := StdEncoding.Decode("1o")
+	require.NoError(t, err)
+	assert.Equal(t, int64(32), d)
+
+	_, err = StdEncoding.Decode("U")
+	require.EqualError(t, err, "base32: invalid decoding character - U")
+}
+
+func TestEncoder_WithCheck(t *testing.T) {
+	cases := map[uint64]string{
+		0:              "00",
+		32:             "10*",
+		1234:           "16JD",
+		36:             "14U",
+		math.MaxUint64: "FZZZZZZZZZZZZB",
+	}
+
+	for k, v := range cases {
+		assert.Equal(t, v, StdEncoding.EncodeWithCheck(k))
+		d, err := StdEncoding.DecodeWithCheck(v)
+		require.NoError(t, err)
+		assert.Equal(t, k, d)
+	}
+
+	d, err := StdEncoding.DecodeWithCheck("14u")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(36), d)
+
+	_, err = StdEncoding.DecodeWithCheck("16JE")
+	require.ErrorIs(t, err, ErrChecksum)
+	_, err = StdEncoding.DecodeWithCheck("1")
+	require.Error(t, err)
+	_, err = StdEncoding.DecodeWithCheck("16J#")
+	require.Error(t, err)
+	_, err = StdEncoding.DecodeWithCheck("*6JD")
+	require.Error(t, err)
+}
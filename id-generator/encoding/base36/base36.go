@@ -0,0 +1,58 @@
+package base36
+
+import (
+	"errors"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/basen"
+)
+
+var (
+	// StdEncoding base36 standard encoder, decoding is case-insensitive.
+	StdEncoding = &Encoder{Encoder: basen.MustNewEncoderWithConfig(&basen.Config{
+		Source:          StdSource(),
+		CaseInsensitive: true,
+		Name:            "base36",
+	})}
+
+	// stdSource base36 standard source string.
+	stdSource = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// ErrOverflow decoding value overflows error.
+	ErrOverflow = basen.ErrOverflow
+	// ErrNegative encoding negative value error.
+	ErrNegative = basen.ErrNegative
+)
+
+// Encoder base36 encoder.
+type Encoder struct {
+	*basen.Encoder
+}
+
+// StdSource returns base36 standard source string.
+func StdSource() string {
+	return stdSource
+}
+
+// MustNewEncoder must new a base36 encoder.
+func MustNewEncoder(source string) *Encoder {
+	enc, err := NewEncoder(source)
+	if err != nil {
+		panic(err)
+	}
+
+	return enc
+}
+
+// NewEncoder new a base36 encoder.
+func NewEncoder(source string) (*Encoder, error) {
+	if len(source) != 36 {
+		return nil, errors.New("base36: encoding source is not 36-bytes long")
+	}
+
+	enc, err := basen.NewEncoderWithConfig(&basen.Config{Source: source, Name: "base36"})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Encoder{Encoder: enc}, nil
+}
@@ -0,0 +1,48 @@
+package base36
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdSource(t *testing.T) {
+	const expect = "0123456789abcdefghijklmnopqrstuvwxyz"
+	assert.Equal(t, expect, StdSource())
+}
+
+func TestNewEncoder(t *testing.T) {
+	enc, err := NewEncoder("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	require.NoError(t, err)
+	assert.NotNil(t, enc)
+
+	_, err = NewEncoder("test")
+	require.EqualError(t, err, "base36: encoding source is not 36-bytes long")
+
+	assert.PanicsWithError(t, "base36: encoding source is not 36-bytes long", func() {
+		MustNewEncoder("test")
+	})
+}
+
+func TestStdEncoding(t *testing.T) {
+	cases := []int64{0, 35, math.MaxInt8, math.MaxInt16, math.MaxInt32, math.MaxInt64}
+
+	for _, c := range cases {
+		e := StdEncoding.Encode(c)
+		assert.Equal(t, strconv.FormatInt(c, 36), e)
+
+		d, err := StdEncoding.Decode(e)
+		require.NoError(t, err)
+		assert.Equal(t, c, d)
+	}
+
+	d, err := StdEncoding.Decode("1Y2P0IJ32E8E7")
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), d)
+
+	_, err = StdEncoding.DecodeUint64("3w5e11264sgsg")
+	require.ErrorIs(t, err, ErrOverflow)
+}
@@ -3,6 +3,7 @@ package base58
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"math/rand"
 	"strconv"
 	"testing"
@@ -146,3 +147,99 @@ func BenchmarkEncoder_Decode(b *testing.B) {
 		})
 	}
 }
+
+func TestEncoder_Negative(t *testing.T) {
+	for _, id := range []int64{-1, math.MinInt64, -123456789} {
+		e := StdEncoding.Encode(id)
+		assert.NotEmpty(t, e)
+		d, err := StdEncoding.Decode(e)
+		require.NoError(t, err)
+		assert.Equal(t, id, d)
+	}
+}
+
+func TestEncoder_Uint64(t *testing.T) {
+	cases := map[uint64]string{
+		0:              "1",
+		57:             "z",
+		math.MaxUint64: "jpXCZedGfVQ",
+	}
+
+	for k, v := range cases {
+		assert.Equal(t, v, StdEncoding.EncodeUint64(k))
+		d, err := StdEncoding.DecodeUint64(v)
+		require.NoError(t, err)
+		assert.Equal(t, k, d)
+	}
+
+	_, err := StdEncoding.DecodeUint64("jpXCZedGfVR")
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = StdEncoding.DecodeUint64("")
+	require.EqualError(t, err, "base58: decoding id should not be empty")
+	_, err = StdEncoding.DecodeUint64("0")
+	require.EqualError(t, err, "base58: invalid decoding character - 0")
+}
+
+func TestEncoder_EncodeWithPadding(t *testing.T) {
+	assert.Equal(t, "11111111111", StdEncoding.EncodeWithPadding(0, 11))
+	assert.Equal(t, "1111111113C", StdEncoding.EncodeWithPadding(math.MaxInt8, 11))
+	assert.Equal(t, "NQm6nKp8qFC", StdEncoding.EncodeWithPadding(math.MaxInt64, 11))
+	assert.Equal(t, "NQm6nKp8qFC", StdEncoding.EncodeWithPadding(math.MaxInt64, 5))
+	assert.Equal(t, "1111111111z", StdEncoding.EncodeUint64WithPadding(57, 11))
+
+	d, err := StdEncoding.Decode("1111111113C")
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt8), d)
+
+	s := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 100; i++ {
+		a, b := s.Int63(), s.Int63()
+		ea, eb := StdEncoding.EncodeWithPadding(a, 11), StdEncoding.EncodeWithPadding(b, 11)
+		assert.Equal(t, a < b, ea < eb)
+	}
+}
+
+func TestEncoder_BigInt(t *testing.T) {
+	n, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	e, err := StdEncoding.EncodeBigInt(n)
+	require.NoError(t, err)
+	d, err := StdEncoding.DecodeBigInt(e)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n.Cmp(d))
+
+	e, err = StdEncoding.EncodeBigInt(big.NewInt(0))
+	require.NoError(t, err)
+	assert.Equal(t, "1", e)
+
+	_, err = StdEncoding.EncodeBigInt(big.NewInt(-1))
+	require.ErrorIs(t, err, ErrNegative)
+	_, err = StdEncoding.DecodeBigInt("")
+	require.Error(t, err)
+	_, err = StdEncoding.DecodeBigInt("0OIl")
+	require.Error(t, err)
+}
+
+func TestEncoder_Bytes(t *testing.T) {
+	cases := []struct {
+		b []byte
+		s string
+	}{
+		{b: []byte{}, s: ""},
+		{b: []byte{0}, s: "1"},
+		{b: []byte{0, 0, 0, 0x28, 0x7f, 0xb4, 0xcd}, s: "111233QC4"},
+		{b: []byte("Hello World!"), s: "2NEpo7TZRRrLZSi2U"},
+		{b: []byte("The quick brown fox jumps over the lazy dog."), s: "USm3fpXnKG5EUBx2ndxBDMPVciP5hGey2Jh4NDv6gmeo1LkMeiKrLJUUBk6Z"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.s, StdEncoding.EncodeBytes(c.b))
+		d, err := StdEncoding.DecodeBytes(c.s)
+		require.NoError(t, err)
+		assert.Equal(t, c.b, d)
+	}
+
+	_, err := StdEncoding.DecodeBytes("1110")
+	require.EqualError(t, err, "base58: invalid decoding character - 0")
+}
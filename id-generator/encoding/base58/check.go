@@ -0,0 +1,54 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"errors"
+)
+
+var (
+	// ErrChecksum checksum mismatch error.
+	ErrChecksum = errors.New("base58: checksum error")
+	// ErrInvalidFormat invalid check encoded format error.
+	ErrInvalidFormat = errors.New("base58: invalid check encoded format")
+)
+
+// checksum returns the first four bytes of the double-SHA256 hash of the input.
+func checksum(input []byte) (cksum [4]byte) {
+	h := sha256.Sum256(input)
+	h2 := sha256.Sum256(h[:])
+	copy(cksum[:], h2[:4])
+
+	return cksum
+}
+
+// CheckEncode base58check encodes the version byte and input,
+// a 4-byte double-SHA256 checksum is appended before encoding.
+func (enc *Encoder) CheckEncode(input []byte, version byte) string {
+	b := make([]byte, 0, 1+len(input)+4)
+	b = append(b, version)
+	b = append(b, input...)
+	cksum := checksum(b)
+	b = append(b, cksum[:]...)
+
+	return enc.EncodeBytes(b)
+}
+
+// CheckDecode base58check decodes the string, verifies the checksum,
+// and returns the decoded input and version byte.
+func (enc *Encoder) CheckDecode(s string) (result []byte, version byte, err error) {
+	decoded, err := enc.DecodeBytes(s)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(decoded) < 5 {
+		return nil, 0, ErrInvalidFormat
+	}
+
+	var cksum [4]byte
+	copy(cksum[:], decoded[len(decoded)-4:])
+	if checksum(decoded[:len(decoded)-4]) != cksum {
+		return nil, 0, ErrChecksum
+	}
+
+	return decoded[1 : len(decoded)-4], decoded[0], nil
+}
@@ -0,0 +1,47 @@
+package base58
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoder_CheckEncode(t *testing.T) {
+	cases := []struct {
+		version byte
+		input   string
+		expect  string
+	}{
+		{version: 20, input: "", expect: "3MNQE1X"},
+		{version: 20, input: " ", expect: "B2Kr6dBE"},
+		{version: 20, input: "-", expect: "B3jv1Aft"},
+	}
+
+	for _, c := range cases {
+		e := StdEncoding.CheckEncode([]byte(c.input), c.version)
+		assert.Equal(t, c.expect, e)
+
+		d, v, err := StdEncoding.CheckDecode(e)
+		require.NoError(t, err)
+		assert.Equal(t, c.version, v)
+		assert.Equal(t, c.input, string(d))
+	}
+
+	// bitcoin p2pkh address
+	hash160, err := hex.DecodeString("010966776006953d5567439e5e39f86a0d273bee")
+	require.NoError(t, err)
+	assert.Equal(t, "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvM", StdEncoding.CheckEncode(hash160, 0))
+}
+
+func TestEncoder_CheckDecode(t *testing.T) {
+	_, _, err := StdEncoding.CheckDecode("3MNQE1Y")
+	require.ErrorIs(t, err, ErrChecksum)
+
+	_, _, err = StdEncoding.CheckDecode("3MNQE")
+	require.ErrorIs(t, err, ErrInvalidFormat)
+
+	_, _, err = StdEncoding.CheckDecode("3MNQE10")
+	require.EqualError(t, err, "base58: invalid decoding character - 0")
+}
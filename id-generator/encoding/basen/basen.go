@@ -0,0 +1,359 @@
+package basen
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+var (
+	// ErrEmpty decoding empty id error.
+	ErrEmpty = errors.New("decoding id should not be empty")
+	// ErrInvalidCharacter invalid decoding character error.
+	ErrInvalidCharacter = errors.New("invalid decoding character")
+	// ErrOverflow decoding value overflows error.
+	ErrOverflow = errors.New("decoding value overflows")
+	// ErrNegative encoding negative value error.
+	ErrNegative = errors.New("encoding value should not be negative")
+)
+
+// Config base-N encoder config.
+type Config struct {
+	Source          string        // encoding source string, 2 to 256 distinct bytes, the first byte is the zero character
+	CaseInsensitive bool          // whether decoding is case-insensitive, the source must not contain both cases of a letter
+	Aliases         map[byte]byte // extra decoding characters mapped to the source characters, e.g. 'O' to '0'
+	Name            string        // encoder name used as the error message prefix, default is baseN
+}
+
+// Encoder base-N encoder.
+type Encoder struct {
+	name      string
+	base      uint64
+	radix     *big.Int
+	encode    []byte
+	decodeMap [256]int
+	growth    float64 // number of encoded characters per byte
+}
+
+// MustNewEncoder must new a base-N encoder, N is the length of source.
+func MustNewEncoder(source string) *Encoder {
+	enc, err := NewEncoder(source)
+	if err != nil {
+		panic(err)
+	}
+
+	return enc
+}
+
+// NewEncoder new a base-N encoder, N is the length of source.
+func NewEncoder(source string) (*Encoder, error) {
+	return NewEncoderWithConfig(&Config{Source: source})
+}
+
+// MustNewEncoderWithConfig must new a base-N encoder with config.
+func MustNewEncoderWithConfig(c *Config) *Encoder {
+	enc, err := NewEncoderWithConfig(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return enc
+}
+
+// NewEncoderWithConfig new a base-N encoder with config.
+func NewEncoderWithConfig(c *Config) (*Encoder, error) {
+	n := len(c.Source)
+	name := c.Name
+	if name == "" {
+		name = "base" + strconv.Itoa(n)
+	}
+	if n < 2 || n > 256 {
+		return nil, errors.New(name + ": encoding source length should be between 2 and 256")
+	}
+
+	enc := &Encoder{
+		name:   name,
+		base:   uint64(n),
+		radix:  big.NewInt(int64(n)),
+		encode: []byte(c.Source),
+		growth: math.Log(256) / math.Log(float64(n)),
+	}
+	for i := range enc.decodeMap {
+		enc.decodeMap[i] = -1
+	}
+
+	for i := 0; i < n; i++ {
+		b := c.Source[i]
+		if enc.decodeMap[b] >= 0 {
+			return nil, fmt.Errorf("%s: duplicate encoding character - %s", name, string(b))
+		}
+		enc.decodeMap[b] = i
+	}
+
+	if c.CaseInsensitive {
+		for i := 0; i < n; i++ {
+			b := c.Source[i]
+			o, ok := otherCase(b)
+			if !ok {
+				continue
+			}
+			if enc.decodeMap[o] >= 0 && enc.decodeMap[o] != i {
+				return nil, fmt.Errorf("%s: case-insensitive encoding character conflicts - %s", name, string(b))
+			}
+			enc.decodeMap[o] = i
+		}
+	}
+
+	for alias, b := range c.Aliases {
+		v := enc.decodeMap[b]
+		if v < 0 {
+			return nil, fmt.Errorf("%s: alias target is not an encoding character - %s", name, string(b))
+		}
+		if enc.decodeMap[alias] >= 0 && enc.decodeMap[alias] != v {
+			return nil, fmt.Errorf("%s: alias conflicts with encoding character - %s", name, string(alias))
+		}
+		enc.decodeMap[alias] = v
+		if o, ok := otherCase(alias); ok && c.CaseInsensitive && enc.decodeMap[o] < 0 {
+			enc.decodeMap[o] = v
+		}
+	}
+
+	return enc, nil
+}
+
+// otherCase returns the other case of an ascii letter.
+func otherCase(b byte) (byte, bool) {
+	switch {
+	case 'a' <= b && b <= 'z':
+		return b - 'a' + 'A', true
+	case 'A' <= b && b <= 'Z':
+		return b - 'A' + 'a', true
+	default:
+		return b, false
+	}
+}
+
+// Base returns the base N of the encoder.
+func (enc *Encoder) Base() int {
+	return int(enc.base)
+}
+
+// Source returns the encoding source string of the encoder.
+func (enc *Encoder) Source() string {
+	return string(enc.encode)
+}
+
+// Encode encodes a int64 id.
+// A negative id is encoded as its two's complement uint64 value,
+// so that it can be decoded back by Decode.
+func (enc *Encoder) Encode(id int64) string {
+	return enc.EncodeUint64(uint64(id))
+}
+
+// Decode decodes a string id.
+func (enc *Encoder) Decode(id string) (int64, error) {
+	n, err := enc.DecodeUint64(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(n), nil
+}
+
+// EncodeInt64 encodes a non-negative int64 id, ErrNegative is returned if the id is negative.
+func (enc *Encoder) EncodeInt64(id int64) (string, error) {
+	if id < 0 {
+		return "", fmt.Errorf("%s: %w", enc.name, ErrNegative)
+	}
+
+	return enc.EncodeUint64(uint64(id)), nil
+}
+
+// DecodeInt64 decodes a string id to a non-negative int64, ErrOverflow is returned if the value overflows int64.
+func (enc *Encoder) DecodeInt64(id string) (int64, error) {
+	n, err := enc.DecodeUint64(id)
+	if err != nil {
+		return 0, err
+	}
+	if n > math.MaxInt64 {
+		return 0, fmt.Errorf("%s: %w", enc.name, ErrOverflow)
+	}
+
+	return int64(n), nil
+}
+
+// EncodeUint64 encodes a uint64 id.
+func (enc *Encoder) EncodeUint64(id uint64) string {
+	if id == 0 {
+		return string(enc.encode[:1])
+	}
+
+	bin := make([]byte, 0, 64)
+	for id > 0 {
+		bin = append(bin, enc.encode[id%enc.base])
+		id /= enc.base
+	}
+
+	reverse(bin)
+
+	return string(bin)
+}
+
+// DecodeUint64 decodes a string id to uint64.
+func (enc *Encoder) DecodeUint64(id string) (uint64, error) {
+	if id == "" {
+		return 0, enc.errEmpty()
+	}
+
+	var n uint64
+	for i := range id {
+		u := enc.decodeMap[id[i]]
+		if u < 0 {
+			return 0, enc.errInvalidCharacter(id[i])
+		}
+		if n > (math.MaxUint64-uint64(u))/enc.base {
+			return 0, fmt.Errorf("%s: %w", enc.name, ErrOverflow)
+		}
+		n = n*enc.base + uint64(u)
+	}
+
+	return n, nil
+}
+
+// EncodeWithPadding encodes a int64 id, and left pads the result
+// with the zero character to the width, so that encoded ids of the same width
+// sort in the same order as the ids when the source is in ascending order.
+// If the encoded id is longer than the width, it is not truncated.
+func (enc *Encoder) EncodeWithPadding(id int64, width int) string {
+	return pad(enc.Encode(id), enc.encode[0], width)
+}
+
+// EncodeUint64WithPadding encodes a uint64 id, and left pads the result
+// with the zero character to the width.
+func (enc *Encoder) EncodeUint64WithPadding(id uint64, width int) string {
+	return pad(enc.EncodeUint64(id), enc.encode[0], width)
+}
+
+// EncodeBigInt encodes a non-negative *big.Int.
+func (enc *Encoder) EncodeBigInt(n *big.Int) (string, error) {
+	if n.Sign() < 0 {
+		return "", fmt.Errorf("%s: %w", enc.name, ErrNegative)
+	}
+	if n.Sign() == 0 {
+		return string(enc.encode[:1]), nil
+	}
+
+	return string(enc.encodeBig(n)), nil
+}
+
+// DecodeBigInt decodes a string to *big.Int.
+func (enc *Encoder) DecodeBigInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, enc.errEmpty()
+	}
+
+	return enc.decodeBig(s)
+}
+
+// EncodeBytes encodes an arbitrary-length byte slice,
+// each leading zero byte is encoded as a leading zero character as Bitcoin base58 does.
+func (enc *Encoder) EncodeBytes(b []byte) string {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+
+	bin := make([]byte, zeros, zeros+int(float64(len(b)-zeros)*enc.growth)+1)
+	for i := range bin {
+		bin[i] = enc.encode[0]
+	}
+	if zeros < len(b) {
+		bin = append(bin, enc.encodeBig(new(big.Int).SetBytes(b[zeros:]))...)
+	}
+
+	return string(bin)
+}
+
+// DecodeBytes decodes a string to byte slice,
+// each leading zero character is decoded as a leading zero byte.
+func (enc *Encoder) DecodeBytes(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && enc.decodeMap[s[zeros]] == 0 {
+		zeros++
+	}
+
+	b := make([]byte, zeros)
+	if zeros < len(s) {
+		n, err := enc.decodeBig(s[zeros:])
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, n.Bytes()...)
+	}
+
+	return b, nil
+}
+
+// encodeBig encodes a positive *big.Int.
+func (enc *Encoder) encodeBig(n *big.Int) []byte {
+	n = new(big.Int).Set(n)
+	mod := new(big.Int)
+
+	bin := make([]byte, 0, int(float64(n.BitLen())/8*enc.growth)+1)
+	for n.Sign() > 0 {
+		n.DivMod(n, enc.radix, mod)
+		bin = append(bin, enc.encode[mod.Int64()])
+	}
+
+	reverse(bin)
+
+	return bin
+}
+
+// decodeBig decodes a string to *big.Int.
+func (enc *Encoder) decodeBig(s string) (*big.Int, error) {
+	n := new(big.Int)
+	u := new(big.Int)
+	for i := range s {
+		v := enc.decodeMap[s[i]]
+		if v < 0 {
+			return nil, enc.errInvalidCharacter(s[i])
+		}
+		n.Mul(n, enc.radix).Add(n, u.SetInt64(int64(v)))
+	}
+
+	return n, nil
+}
+
+func (enc *Encoder) errEmpty() error {
+	return fmt.Errorf("%s: %w", enc.name, ErrEmpty)
+}
+
+func (enc *Encoder) errInvalidCharacter(c byte) error {
+	return fmt.Errorf("%s: %w - %s", enc.name, ErrInvalidCharacter, string(c))
+}
+
+// reverse reverses the byte slice in place.
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
+
+// pad left pads s with c to the width.
+func pad(s string, c byte, width int) string {
+	if len(s) >= width {
+		return s
+	}
+
+	b := make([]byte, width)
+	p := width - len(s)
+	for i := 0; i < p; i++ {
+		b[i] = c
+	}
+	copy(b[p:], s)
+
+	return string(b)
+}
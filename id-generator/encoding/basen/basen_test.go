@@ -0,0 +1,134 @@
+package basen
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEncoderWithConfig(t *testing.T) {
+	cases := []struct {
+		c      Config
+		errMsg string
+	}{
+		{c: Config{Source: "0"}, errMsg: "base1: encoding source length should be between 2 and 256"},
+		{c: Config{Source: "0120"}, errMsg: "base4: duplicate encoding character - 0"},
+		{c: Config{Source: "01aA", CaseInsensitive: true}, errMsg: "base4: case-insensitive encoding character conflicts - a"},
+		{c: Config{Source: "01ab", Aliases: map[byte]byte{'o': 'c'}}, errMsg: "base4: alias target is not an encoding character - c"},
+		{c: Config{Source: "01ab", Aliases: map[byte]byte{'a': '0'}}, errMsg: "base4: alias conflicts with encoding character - a"},
+		{c: Config{Source: "0", Name: "custom"}, errMsg: "custom: encoding source length should be between 2 and 256"},
+	}
+
+	for _, c := range cases {
+		c := c
+		_, err := NewEncoderWithConfig(&c.c)
+		require.EqualError(t, err, c.errMsg)
+	}
+
+	enc, err := NewEncoderWithConfig(&Config{Source: "01ab", CaseInsensitive: true, Aliases: map[byte]byte{'o': '0'}})
+	require.NoError(t, err)
+	assert.Equal(t, 4, enc.Base())
+	assert.Equal(t, "01ab", enc.Source())
+
+	d, err := enc.DecodeUint64("1AbO")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1*64+2*16+3*4+0), d)
+
+	assert.Panics(t, func() { MustNewEncoder("00") })
+	assert.Panics(t, func() { MustNewEncoderWithConfig(&Config{Source: "00"}) })
+}
+
+func TestEncoder(t *testing.T) {
+	bin := MustNewEncoder("01")
+	assert.Equal(t, "0", bin.Encode(0))
+	assert.Equal(t, "101", bin.Encode(5))
+	assert.Equal(t, "0000101", bin.EncodeWithPadding(5, 7))
+	assert.Equal(t, "0000101", bin.EncodeUint64WithPadding(5, 7))
+
+	hex := MustNewEncoder("0123456789abcdef")
+	assert.Equal(t, "ffffffffffffffff", hex.EncodeUint64(math.MaxUint64))
+	assert.Equal(t, "ffffffffffffffff", hex.Encode(-1))
+
+	d, err := hex.Decode("ffffffffffffffff")
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), d)
+
+	_, err = hex.DecodeUint64("10000000000000000")
+	require.ErrorIs(t, err, ErrOverflow)
+	require.EqualError(t, err, "base16: decoding value overflows")
+
+	_, err = hex.Decode("")
+	require.ErrorIs(t, err, ErrEmpty)
+
+	_, err = hex.Decode("fg")
+	require.ErrorIs(t, err, ErrInvalidCharacter)
+	require.EqualError(t, err, "base16: invalid decoding character - g")
+}
+
+func TestEncoder_Int64(t *testing.T) {
+	hex := MustNewEncoder("0123456789abcdef")
+
+	e, err := hex.EncodeInt64(255)
+	require.NoError(t, err)
+	assert.Equal(t, "ff", e)
+	d, err := hex.DecodeInt64("7fffffffffffffff")
+	require.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), d)
+
+	_, err = hex.EncodeInt64(-1)
+	require.ErrorIs(t, err, ErrNegative)
+	require.EqualError(t, err, "base16: encoding value should not be negative")
+	_, err = hex.DecodeInt64("ffffffffffffffff")
+	require.ErrorIs(t, err, ErrOverflow)
+	_, err = hex.DecodeInt64("")
+	require.ErrorIs(t, err, ErrEmpty)
+}
+
+func TestEncoder_BigInt(t *testing.T) {
+	hex := MustNewEncoder("0123456789abcdef")
+	n, ok := new(big.Int).SetString("123456789abcdef0123456789abcdef", 16)
+	require.True(t, ok)
+
+	e, err := hex.EncodeBigInt(n)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789abcdef0123456789abcdef", e)
+
+	d, err := hex.DecodeBigInt(e)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n.Cmp(d))
+
+	e, err = hex.EncodeBigInt(new(big.Int))
+	require.NoError(t, err)
+	assert.Equal(t, "0", e)
+
+	_, err = hex.EncodeBigInt(big.NewInt(-1))
+	require.ErrorIs(t, err, ErrNegative)
+	_, err = hex.DecodeBigInt("")
+	require.ErrorIs(t, err, ErrEmpty)
+}
+
+func TestEncoder_Bytes(t *testing.T) {
+	hex := MustNewEncoder("0123456789abcdef")
+	cases := []struct {
+		b []byte
+		s string
+	}{
+		{b: []byte{}, s: ""},
+		{b: []byte{0, 0}, s: "00"},
+		{b: []byte{0, 0x12, 0x34}, s: "01234"},
+		{b: []byte{0xde, 0xad, 0xbe, 0xef}, s: "deadbeef"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.s, hex.EncodeBytes(c.b))
+		d, err := hex.DecodeBytes(c.s)
+		require.NoError(t, err)
+		assert.Equal(t, c.b, d)
+	}
+
+	_, err := hex.DecodeBytes("0z")
+	require.ErrorIs(t, err, ErrInvalidCharacter)
+}
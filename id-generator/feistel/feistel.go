@@ -0,0 +1,208 @@
+package feistel
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"hash"
+)
+
+// feistel network, used to scramble sequential ids into random-looking ids of the same bit width
+// the id is split into the left and right halves, in each round:
+// L(i+1) = R(i)
+// R(i+1) = L(i) xor F(key, i, R(i))
+// F is HMAC-SHA256 truncated to the half width, and the network is reversed by running the rounds backwards
+// odd bit widths are handled by cycle walking, which re-encrypts until the result fits in the width
+
+const (
+	defaultBits   = 63 // default bit width, the non-negative int64 range
+	defaultRounds = 8  // default number of rounds
+	minBits       = 2  // min bit width
+	maxBits       = 64 // max bit width
+)
+
+var (
+	// ErrEmptyKey empty key error.
+	ErrEmptyKey = errors.New("feistel: key should not be empty")
+	// ErrInvalidBits invalid bit width error.
+	ErrInvalidBits = errors.New("feistel: bits must be between 2 and 64")
+	// ErrInvalidRounds invalid rounds error.
+	ErrInvalidRounds = errors.New("feistel: rounds must be at least 2")
+	// ErrOutOfRange id out of range error.
+	ErrOutOfRange = errors.New("feistel: id is out of the bit width range")
+)
+
+// Encoder int64 id string encoder, e.g. base58.StdEncoding and base62.StdEncoding.
+type Encoder interface {
+	EncodeInt64(id int64) (string, error)
+	DecodeInt64(id string) (int64, error)
+}
+
+// Config feistel config.
+type Config struct {
+	Key    []byte // secret key
+	Bits   int    // bit width of ids, default is 63, ids of 64 bits may be negative
+	Rounds int    // number of rounds, default is 8
+}
+
+// Feistel keyed format-preserving id scrambler based on feistel network,
+// it is a permutation of [0, 2^bits), so that different ids are never scrambled into the same id.
+type Feistel struct {
+	key      []byte // secret key
+	bits     uint   // bit width of ids
+	halfBits uint   // bit width of each half
+	halfMask uint64 // mask of each half
+	rounds   int    // number of rounds
+}
+
+// MustNewFeistel must new a feistel scrambler.
+func MustNewFeistel(c *Config) *Feistel {
+	f, err := NewFeistel(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+// NewFeistel new a feistel scrambler.
+func NewFeistel(c *Config) (*Feistel, error) {
+	if len(c.Key) == 0 {
+		return nil, ErrEmptyKey
+	}
+
+	bits := c.Bits
+	if bits == 0 {
+		bits = defaultBits
+	}
+	if bits < minBits || bits > maxBits {
+		return nil, ErrInvalidBits
+	}
+
+	rounds := c.Rounds
+	if rounds == 0 {
+		rounds = defaultRounds
+	}
+	if rounds < 2 {
+		return nil, ErrInvalidRounds
+	}
+
+	key := make([]byte, len(c.Key))
+	copy(key, c.Key)
+	halfBits := uint(bits+1) / 2
+
+	return &Feistel{
+		key:      key,
+		bits:     uint(bits),
+		halfBits: halfBits,
+		halfMask: 1<<halfBits - 1,
+		rounds:   rounds,
+	}, nil
+}
+
+// Bits returns the bit width of ids.
+func (f *Feistel) Bits() int {
+	return int(f.bits)
+}
+
+// Encrypt scrambles the id into a random-looking id of the same bit width.
+func (f *Feistel) Encrypt(id int64) (int64, error) {
+	v, err := f.check(id)
+	if err != nil {
+		return 0, err
+	}
+
+	h := hmac.New(sha256.New, f.key)
+	for {
+		v = f.encrypt(h, v)
+		if f.inRange(v) {
+			return int64(v), nil
+		}
+	}
+}
+
+// Decrypt restores the scrambled id to the original id.
+func (f *Feistel) Decrypt(id int64) (int64, error) {
+	v, err := f.check(id)
+	if err != nil {
+		return 0, err
+	}
+
+	h := hmac.New(sha256.New, f.key)
+	for {
+		v = f.decrypt(h, v)
+		if f.inRange(v) {
+			return int64(v), nil
+		}
+	}
+}
+
+// EncryptString scrambles the id and encodes it by the encoder,
+// the encoder rejects the negative scrambled ids of 64 bits.
+func (f *Feistel) EncryptString(id int64, enc Encoder) (string, error) {
+	v, err := f.Encrypt(id)
+	if err != nil {
+		return "", err
+	}
+
+	return enc.EncodeInt64(v)
+}
+
+// DecryptString decodes the id by the encoder and restores it to the original id.
+func (f *Feistel) DecryptString(id string, enc Encoder) (int64, error) {
+	v, err := enc.DecodeInt64(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return f.Decrypt(v)
+}
+
+// check checks the id is in the bit width range.
+func (f *Feistel) check(id int64) (uint64, error) {
+	v := uint64(id)
+	if !f.inRange(v) {
+		return 0, ErrOutOfRange
+	}
+
+	return v, nil
+}
+
+// inRange reports whether v is in [0, 2^bits).
+func (f *Feistel) inRange(v uint64) bool {
+	return f.bits == maxBits || v>>f.bits == 0
+}
+
+// encrypt runs the feistel rounds forwards.
+func (f *Feistel) encrypt(h hash.Hash, v uint64) uint64 {
+	l, r := v>>f.halfBits&f.halfMask, v&f.halfMask
+	for i := 0; i < f.rounds; i++ {
+		l, r = r, l^f.round(h, i, r)
+	}
+
+	return l<<f.halfBits | r
+}
+
+// decrypt runs the feistel rounds backwards.
+func (f *Feistel) decrypt(h hash.Hash, v uint64) uint64 {
+	l, r := v>>f.halfBits&f.halfMask, v&f.halfMask
+	for i := f.rounds - 1; i >= 0; i-- {
+		l, r = r^f.round(h, i, l), l
+	}
+
+	return l<<f.halfBits | r
+}
+
+// round is the round function F(key, i, r).
+func (f *Feistel) round(h hash.Hash, i int, r uint64) uint64 {
+	var b [9]byte
+	b[0] = byte(i)
+	binary.BigEndian.PutUint64(b[1:], r)
+
+	h.Reset()
+	h.Write(b[:])
+	sum := h.Sum(nil)
+
+	return binary.BigEndian.Uint64(sum) & f.halfMask
+}
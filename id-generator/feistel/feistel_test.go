@@ -0,0 +1,134 @@
+package feistel
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/base58"
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/base62"
+)
+
+var testKey = []byte("test secret key")
+
+func TestNewFeistel(t *testing.T) {
+	cases := []struct {
+		c   *Config
+		err error
+	}{
+		{c: &Config{}, err: ErrEmptyKey},
+		{c: &Config{Key: testKey, Bits: 1}, err: ErrInvalidBits},
+		{c: &Config{Key: testKey, Bits: 65}, err: ErrInvalidBits},
+		{c: &Config{Key: testKey, Rounds: 1}, err: ErrInvalidRounds},
+	}
+
+	for _, c := range cases {
+		_, err := NewFeistel(c.c)
+		require.ErrorIs(t, err, c.err)
+	}
+
+	f, err := NewFeistel(&Config{Key: testKey})
+	require.NoError(t, err)
+	assert.Equal(t, 63, f.Bits())
+
+	assert.Panics(t, func() { MustNewFeistel(&Config{}) })
+}
+
+func TestFeistel_Permutation(t *testing.T) {
+	for _, bits := range []int{2, 3, 8, 11, 12} {
+		f := MustNewFeistel(&Config{Key: testKey, Bits: bits})
+		size := int64(1) << uint(bits)
+		seen := make(map[int64]struct{}, size)
+
+		for id := int64(0); id < size; id++ {
+			e, err := f.Encrypt(id)
+			require.NoError(t, err)
+			assert.True(t, e >= 0 && e < size)
+			seen[e] = struct{}{}
+
+			d, err := f.Decrypt(e)
+			require.NoError(t, err)
+			assert.Equal(t, id, d)
+		}
+		assert.Len(t, seen, int(size))
+
+		_, err := f.Encrypt(size)
+		require.ErrorIs(t, err, ErrOutOfRange)
+		_, err = f.Decrypt(-1)
+		require.ErrorIs(t, err, ErrOutOfRange)
+	}
+}
+
+func TestFeistel_Encrypt(t *testing.T) {
+	f := MustNewFeistel(&Config{Key: testKey})
+	f2 := MustNewFeistel(&Config{Key: []byte("another key")})
+
+	prev := int64(-1)
+	for id := int64(1); id <= 1000; id++ {
+		e, err := f.Encrypt(id)
+		require.NoError(t, err)
+		assert.True(t, e >= 0)
+		assert.NotEqual(t, prev+1, e)
+		prev = e
+
+		d, err := f.Decrypt(e)
+		require.NoError(t, err)
+		assert.Equal(t, id, d)
+
+		e2, err := f2.Encrypt(id)
+		require.NoError(t, err)
+		assert.NotEqual(t, e, e2)
+	}
+
+	for _, id := range []int64{0, math.MaxInt64} {
+		e, err := f.Encrypt(id)
+		require.NoError(t, err)
+		d, err := f.Decrypt(e)
+		require.NoError(t, err)
+		assert.Equal(t, id, d)
+	}
+
+	_, err := f.Encrypt(-1)
+	require.ErrorIs(t, err, ErrOutOfRange)
+
+	f64 := MustNewFeistel(&Config{Key: testKey, Bits: 64})
+	for _, id := range []int64{-1, math.MinInt64, 0, math.MaxInt64} {
+		e, err := f64.Encrypt(id)
+		require.NoError(t, err)
+		d, err := f64.Decrypt(e)
+		require.NoError(t, err)
+		assert.Equal(t, id, d)
+	}
+}
+
+func TestFeistel_EncryptString(t *testing.T) {
+	f := MustNewFeistel(&Config{Key: testKey, Bits: 40})
+
+	for _, enc := range []Encoder{base58.StdEncoding, base62.StdEncoding} {
+		for id := int64(0); id < 100; id++ {
+			s, err := f.EncryptString(id, enc)
+			require.NoError(t, err)
+
+			d, err := f.DecryptString(s, enc)
+			require.NoError(t, err)
+			assert.Equal(t, id, d)
+		}
+	}
+
+	_, err := f.EncryptString(-1, base58.StdEncoding)
+	require.ErrorIs(t, err, ErrOutOfRange)
+	_, err = f.DecryptString("0", base58.StdEncoding)
+	require.Error(t, err)
+	_, err = f.DecryptString("zzzzzzzzzz", base62.StdEncoding)
+	require.ErrorIs(t, err, ErrOutOfRange)
+}
+
+func BenchmarkFeistel_Encrypt(b *testing.B) {
+	f := MustNewFeistel(&Config{Key: testKey})
+
+	for i := 0; i < b.N; i++ {
+		_, _ = f.Encrypt(int64(i))
+	}
+}
@@ -0,0 +1,25 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package flock
+
+import (
+	"errors"
+	"os"
+)
+
+// Supported reports whether flock is supported on this platform.
+const Supported = false
+
+// ErrUnsupported flock unsupported error.
+var ErrUnsupported = errors.New("flock is not supported on this platform")
+
+// TryLock tries to place an exclusive flock on the file without blocking,
+// it reports false if the file is locked by others.
+func TryLock(_ *os.File) (bool, error) {
+	return false, ErrUnsupported
+}
+
+// Unlock removes the flock on the file.
+func Unlock(_ *os.File) error {
+	return ErrUnsupported
+}
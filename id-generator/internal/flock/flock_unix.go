@@ -0,0 +1,31 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package flock
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// Supported reports whether flock is supported on this platform.
+const Supported = true
+
+// TryLock tries to place an exclusive flock on the file without blocking,
+// it reports false if the file is locked by others.
+func TryLock(f *os.File) (bool, error) {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err != nil {
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Unlock removes the flock on the file.
+func Unlock(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,282 @@
+package ksuid
+
+import (
+	"bytes"
+	"crypto/rand"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/base62"
+)
+
+// +----------------------------------+------------------------------------------------------------------------------+
+// | timestamp (32 bits)              | payload (128 bits)                                                           |
+// +----------------------------------+------------------------------------------------------------------------------+
+// | 0ujtsYcgvSTl8PAuAdqWYSMnLOv (27 characters of base62)                                                           |
+// +----------------------------------+------------------------------------------------------------------------------+
+
+// ksuid (k-sortable unique identifier), 160 bits, compatible with segmentio/ksuid
+// 32 bits timestamp (second), subtract the epoch 1400000000 from the unix timestamp, which can be used until the year 2150
+// 128 bits payload, random bytes
+// the text form is 27 characters of base62 left padded with 0, which sorts in the same order as the binary form
+
+const (
+	// EncodedSize ksuid text encoded size.
+	EncodedSize = 27
+	// BinarySize ksuid binary size.
+	BinarySize = 20
+	// PayloadSize ksuid payload size.
+	PayloadSize = 16
+
+	epochStamp int64 = 1400000000 // epoch unix timestamp (second), 2014-05-13 16:53:20 UTC
+)
+
+var (
+	// ErrBigTime big time error, the time is out of the ksuid time range.
+	ErrBigTime = errors.New("ksuid: time is out of range")
+	// ErrDataSize data size error.
+	ErrDataSize = errors.New("ksuid: bad data size when unmarshalling")
+	// ErrInvalidCharacters invalid characters error.
+	ErrInvalidCharacters = errors.New("ksuid: bad data characters when unmarshalling")
+	// ErrOverflow overflow error, the text form is larger than the max ksuid.
+	ErrOverflow = errors.New("ksuid: overflow when unmarshalling")
+	// ErrScanValue scan value error.
+	ErrScanValue = errors.New("ksuid: source value must be a string or byte slice")
+
+	// Nil nil ksuid.
+	Nil KSUID
+	// Max max ksuid.
+	Max = KSUID{
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+		0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	}
+)
+
+// KSUID k-sortable unique identifier.
+type KSUID [BinarySize]byte
+
+// New generates ksuid by the current time and random payload.
+func New() (KSUID, error) {
+	return NewAt(time.Now())
+}
+
+// NewAt generates ksuid by the time and random payload.
+func NewAt(t time.Time) (KSUID, error) {
+	return NewWithEntropy(t, rand.Reader)
+}
+
+// NewWithEntropy generates ksuid by the time and payload read from the entropy.
+func NewWithEntropy(t time.Time, entropy io.Reader) (KSUID, error) {
+	var payload [PayloadSize]byte
+	if _, err := io.ReadFull(entropy, payload[:]); err != nil {
+		return Nil, err
+	}
+
+	return FromParts(t, payload[:])
+}
+
+// Next generates ksuid string, it panics if an error occurred.
+func Next() string {
+	k, err := New()
+	if err != nil {
+		panic(err)
+	}
+
+	return k.String()
+}
+
+// FromParts creates ksuid by the time and 16 bytes payload.
+func FromParts(t time.Time, payload []byte) (KSUID, error) {
+	if len(payload) != PayloadSize {
+		return Nil, ErrDataSize
+	}
+
+	ts := t.Unix() - epochStamp
+	if ts < 0 || ts > 1<<32-1 {
+		return Nil, ErrBigTime
+	}
+
+	var k KSUID
+	k[0], k[1], k[2], k[3] = byte(ts>>24), byte(ts>>16), byte(ts>>8), byte(ts)
+	copy(k[4:], payload)
+
+	return k, nil
+}
+
+// FromBytes creates ksuid by the 20 bytes binary form.
+func FromBytes(b []byte) (KSUID, error) {
+	var k KSUID
+	return k, k.UnmarshalBinary(b)
+}
+
+// Parse parses ksuid string.
+func Parse(s string) (KSUID, error) {
+	var k KSUID
+	return k, k.UnmarshalText([]byte(s))
+}
+
+// MustParse must parse ksuid string.
+func MustParse(s string) KSUID {
+	k, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// Compare returns an integer comparing two ksuids.
+// The result will be 0 if a == b, -1 if a < b, and +1 if a > b.
+func Compare(a, b KSUID) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// Sort sorts the ksuids in ascending order.
+func Sort(ks []KSUID) {
+	sort.Slice(ks, func(i, j int) bool { return Compare(ks[i], ks[j]) < 0 })
+}
+
+// IsSorted reports whether the ksuids are sorted in ascending order.
+func IsSorted(ks []KSUID) bool {
+	return sort.SliceIsSorted(ks, func(i, j int) bool { return Compare(ks[i], ks[j]) < 0 })
+}
+
+// Timestamp returns the timestamp of ksuid, the seconds since the ksuid epoch.
+func (k KSUID) Timestamp() uint32 {
+	return uint32(k[0])<<24 | uint32(k[1])<<16 | uint32(k[2])<<8 | uint32(k[3])
+}
+
+// Time returns the generate time of ksuid.
+func (k KSUID) Time() time.Time {
+	return time.Unix(int64(k.Timestamp())+epochStamp, 0)
+}
+
+// Payload returns the payload bytes of ksuid.
+func (k KSUID) Payload() []byte {
+	p := make([]byte, PayloadSize)
+	copy(p, k[4:])
+
+	return p
+}
+
+// Bytes returns the binary bytes of ksuid.
+func (k KSUID) Bytes() []byte {
+	b := make([]byte, BinarySize)
+	copy(b, k[:])
+
+	return b
+}
+
+// IsNil reports whether ksuid is nil.
+func (k KSUID) IsNil() bool {
+	return k == Nil
+}
+
+// Compare returns an integer comparing ksuid to the other.
+func (k KSUID) Compare(other KSUID) int {
+	return Compare(k, other)
+}
+
+// Next returns the next ksuid in order, which is ksuid + 1, Max.Next() wraps around to Nil.
+func (k KSUID) Next() KSUID {
+	for i := BinarySize - 1; i >= 0; i-- {
+		k[i]++
+		if k[i] != 0 {
+			break
+		}
+	}
+
+	return k
+}
+
+// Prev returns the previous ksuid in order, which is ksuid - 1, Nil.Prev() wraps around to Max.
+func (k KSUID) Prev() KSUID {
+	for i := BinarySize - 1; i >= 0; i-- {
+		k[i]--
+		if k[i] != 0xFF {
+			break
+		}
+	}
+
+	return k
+}
+
+// String returns the base62 text form of ksuid.
+func (k KSUID) String() string {
+	s, _ := base62.StdEncoding.EncodeBigInt(new(big.Int).SetBytes(k[:]))
+	if len(s) < EncodedSize {
+		s = strings.Repeat(base62.StdSource()[:1], EncodedSize-len(s)) + s
+	}
+
+	return s
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (k KSUID) MarshalBinary() ([]byte, error) {
+	return k.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (k *KSUID) UnmarshalBinary(data []byte) error {
+	if len(data) != BinarySize {
+		return ErrDataSize
+	}
+	copy(k[:], data)
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface,
+// ksuid is marshalled to JSON as a string by it.
+func (k KSUID) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (k *KSUID) UnmarshalText(data []byte) error {
+	if len(data) != EncodedSize {
+		return ErrDataSize
+	}
+
+	n, err := base62.StdEncoding.DecodeBigInt(string(data))
+	if err != nil {
+		return ErrInvalidCharacters
+	}
+	if n.BitLen() > BinarySize*8 {
+		return ErrOverflow
+	}
+	n.FillBytes(k[:])
+
+	return nil
+}
+
+// Scan implements the sql.Scanner interface,
+// it accepts the text form string and the text or binary form bytes.
+func (k *KSUID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*k = Nil
+		return nil
+	case string:
+		return k.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == BinarySize {
+			return k.UnmarshalBinary(v)
+		}
+		return k.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w, got %T", ErrScanValue, src)
+	}
+}
+
+// Value implements the driver.Valuer interface,
+// ksuid is stored as the text form string, which keeps the ordering.
+func (k KSUID) Value() (driver.Value, error) {
+	return k.String(), nil
+}
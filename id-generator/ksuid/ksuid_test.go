@@ -0,0 +1,127 @@
+package ksuid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	k, err := Parse("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(107608047), k.Timestamp())
+	assert.Equal(t, time.Unix(1507608047, 0), k.Time())
+	assert.Equal(t, "b5a1cd34b5f99d1154fb6853345c9735", hex.EncodeToString(k.Payload()))
+	assert.Equal(t, "0ujtsYcgvSTl8PAuAdqWYSMnLOv", k.String())
+
+	assert.Equal(t, "000000000000000000000000000", Nil.String())
+	assert.Equal(t, "aWgEPTl1tmebfsQzFP4bxwgy80V", Max.String())
+	assert.Equal(t, Max, MustParse("aWgEPTl1tmebfsQzFP4bxwgy80V"))
+
+	_, err = Parse("aWgEPTl1tmebfsQzFP4bxwgy80W")
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = Parse("0ujtsYcgvSTl8PAuAdqWYSMnLO")
+	assert.ErrorIs(t, err, ErrDataSize)
+	_, err = Parse("0ujtsYcgvSTl8PAuAdqWYSMnLO-")
+	assert.ErrorIs(t, err, ErrInvalidCharacters)
+	assert.Panics(t, func() { MustParse("") })
+}
+
+func TestNew(t *testing.T) {
+	now := time.Now()
+	k, err := New()
+	require.NoError(t, err)
+	assert.False(t, k.IsNil())
+	assert.WithinDuration(t, now, k.Time(), time.Second)
+	assert.Len(t, Next(), EncodedSize)
+
+	k, err = NewWithEntropy(time.Unix(1507608047, 0), bytes.NewReader(bytes.Repeat([]byte{0xFF}, PayloadSize)))
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("ff", PayloadSize), hex.EncodeToString(k.Payload()))
+
+	_, err = NewAt(time.Unix(epochStamp-1, 0))
+	assert.ErrorIs(t, err, ErrBigTime)
+	_, err = NewAt(time.Unix(epochStamp+1<<32, 0))
+	assert.ErrorIs(t, err, ErrBigTime)
+	_, err = NewWithEntropy(time.Now(), bytes.NewReader(nil))
+	assert.Error(t, err)
+	_, err = FromParts(time.Now(), []byte{1})
+	assert.ErrorIs(t, err, ErrDataSize)
+
+	b, err := FromBytes(k.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, k, b)
+	_, err = FromBytes([]byte{1})
+	assert.ErrorIs(t, err, ErrDataSize)
+}
+
+func TestKSUID_NextPrev(t *testing.T) {
+	k := MustParse("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	assert.Equal(t, 1, k.Next().Compare(k))
+	assert.Equal(t, -1, k.Prev().Compare(k))
+	assert.Equal(t, k, k.Next().Prev())
+	assert.Equal(t, Nil, Max.Next())
+	assert.Equal(t, Max, Nil.Prev())
+
+	var last KSUID
+	last[BinarySize-1] = 0xFF
+	assert.Equal(t, "000000000000000000000000048", last.Next().String())
+}
+
+func TestSort(t *testing.T) {
+	ks := make([]KSUID, 0, 100)
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		k, err := NewAt(now.Add(time.Duration(100-i) * time.Second))
+		require.NoError(t, err)
+		ks = append(ks, k)
+	}
+	assert.False(t, IsSorted(ks))
+
+	Sort(ks)
+	assert.True(t, IsSorted(ks))
+	for i := 1; i < len(ks); i++ {
+		assert.Less(t, ks[i-1].String(), ks[i].String())
+	}
+}
+
+func TestKSUID_Marshal(t *testing.T) {
+	type data struct {
+		Id KSUID `json:"id"`
+	}
+
+	k := MustParse("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	b, err := json.Marshal(data{Id: k})
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"0ujtsYcgvSTl8PAuAdqWYSMnLOv"}`, string(b))
+
+	var d data
+	require.NoError(t, json.Unmarshal(b, &d))
+	assert.Equal(t, k, d.Id)
+
+	v, err := k.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "0ujtsYcgvSTl8PAuAdqWYSMnLOv", v)
+
+	var s KSUID
+	require.NoError(t, s.Scan("0ujtsYcgvSTl8PAuAdqWYSMnLOv"))
+	assert.Equal(t, k, s)
+	require.NoError(t, s.Scan(k.Bytes()))
+	assert.Equal(t, k, s)
+	require.NoError(t, s.Scan([]byte("0ujtsYcgvSTl8PAuAdqWYSMnLOv")))
+	assert.Equal(t, k, s)
+	require.NoError(t, s.Scan(nil))
+	assert.True(t, s.IsNil())
+	assert.ErrorIs(t, s.Scan(1), ErrScanValue)
+
+	b, err = k.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, s.UnmarshalBinary(b))
+	assert.Equal(t, k, s)
+}
@@ -0,0 +1,160 @@
+package nanoid
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// nanoid, a tiny, secure and url-friendly unique string id generator compatible with ai/nanoid
+// random bytes are masked to the smallest power of two covering the alphabet,
+// and the bytes outside the alphabet are skipped to avoid modulo bias
+
+const (
+	// DefaultAlphabet default url-safe alphabet of nanoid.
+	DefaultAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+	// DefaultSize default size of nanoid, which has a similar collision probability to uuid v4.
+	DefaultSize = 21
+	// ShortCodeAlphabet alphabet of short codes, upper case letters and digits
+	// excluding the ambiguous characters 0, O, 1 and I.
+	ShortCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+)
+
+var (
+	// ErrInvalidAlphabet invalid alphabet error.
+	ErrInvalidAlphabet = errors.New("nanoid: alphabet must contain 2 to 256 unique characters")
+	// ErrInvalidSize invalid size error.
+	ErrInvalidSize = errors.New("nanoid: size must be positive")
+)
+
+// New generates nanoid by the default alphabet and size, default size is 21.
+func New(size ...int) (string, error) {
+	s := DefaultSize
+	if len(size) != 0 {
+		s = size[0]
+	}
+
+	return Generate(DefaultAlphabet, s)
+}
+
+// MustNew generates nanoid by the default alphabet and size, default size is 21.
+// It panics if any error occurs.
+func MustNew(size ...int) string {
+	id, err := New(size...)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// ShortCode generates short code by the short code alphabet and size,
+// it is suitable for invite codes and verification codes that may be read or typed by humans.
+func ShortCode(size int) (string, error) {
+	return Generate(ShortCodeAlphabet, size)
+}
+
+// MustShortCode generates short code by the short code alphabet and size.
+// It panics if any error occurs.
+func MustShortCode(size int) string {
+	code, err := ShortCode(size)
+	if err != nil {
+		panic(err)
+	}
+
+	return code
+}
+
+// Generate generates nanoid by the custom alphabet and size.
+func Generate(alphabet string, size int) (string, error) {
+	if err := checkAlphabet(alphabet); err != nil {
+		return "", err
+	}
+	if size <= 0 {
+		return "", ErrInvalidSize
+	}
+
+	mask := 1<<bits.Len(uint(len(alphabet)-1)) - 1
+	step := int(math.Ceil(1.6 * float64(mask*size) / float64(len(alphabet))))
+
+	id := make([]byte, 0, size)
+	b := make([]byte, step)
+	for {
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+
+		for _, rb := range b {
+			i := int(rb) & mask
+			if i >= len(alphabet) { // skip to avoid modulo bias
+				continue
+			}
+
+			id = append(id, alphabet[i])
+			if len(id) == size {
+				return string(id), nil
+			}
+		}
+	}
+}
+
+// MustGenerate generates nanoid by the custom alphabet and size.
+// It panics if any error occurs.
+func MustGenerate(alphabet string, size int) string {
+	id, err := Generate(alphabet, size)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// CollisionProbability estimates the probability of at least one collision
+// among n ids generated by the alphabet size and id size, based on the birthday problem.
+func CollisionProbability(alphabetSize, size int, n float64) float64 {
+	if alphabetSize < 1 || size <= 0 || n < 2 {
+		return 0
+	}
+
+	// total is the number of possible ids, p = 1 - e^(-n(n-1)/2total)
+	total := math.Pow(float64(alphabetSize), float64(size))
+	if n > total {
+		return 1
+	}
+
+	return -math.Expm1(-n * (n - 1) / (2 * total))
+}
+
+// SizeFor returns the min id size by the alphabet size, so that the collision probability
+// among n ids does not exceed the probability p, it returns -1 if it is not reachable.
+func SizeFor(alphabetSize int, n, p float64) int {
+	if alphabetSize < 2 || p <= 0 || p > 1 {
+		return -1
+	}
+
+	for size := 1; size <= 1024; size++ {
+		if CollisionProbability(alphabetSize, size, n) <= p {
+			return size
+		}
+	}
+
+	return -1
+}
+
+// checkAlphabet checks whether the alphabet is valid.
+func checkAlphabet(alphabet string) error {
+	if len(alphabet) < 2 || len(alphabet) > 256 {
+		return ErrInvalidAlphabet
+	}
+
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		if seen[alphabet[i]] {
+			return ErrInvalidAlphabet
+		}
+		seen[alphabet[i]] = true
+	}
+
+	return nil
+}
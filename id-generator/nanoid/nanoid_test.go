@@ -0,0 +1,75 @@
+package nanoid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	id, err := New()
+	require.NoError(t, err)
+	assert.Len(t, id, DefaultSize)
+	assert.Regexp(t, `^[A-Za-z0-9_-]+$`, id)
+
+	id = MustNew(10)
+	assert.Len(t, id, 10)
+
+	_, err = New(0)
+	assert.ErrorIs(t, err, ErrInvalidSize)
+
+	set := make(map[string]struct{})
+	for i := 0; i < 10000; i++ {
+		set[MustNew()] = struct{}{}
+	}
+	assert.Len(t, set, 10000)
+}
+
+func TestShortCode(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		code := MustShortCode(8)
+		assert.Len(t, code, 8)
+		assert.False(t, strings.ContainsAny(code, "0O1lI"), code)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	id, err := Generate("abc", 100)
+	require.NoError(t, err)
+	assert.Len(t, id, 100)
+	assert.Regexp(t, `^[abc]+$`, id)
+
+	counts := make(map[rune]int)
+	for _, r := range MustGenerate("0123456789", 100000) {
+		counts[r]++
+	}
+	assert.Len(t, counts, 10)
+	for r, c := range counts {
+		assert.InDelta(t, 10000, c, 1000, string(r))
+	}
+
+	_, err = Generate("a", 10)
+	assert.ErrorIs(t, err, ErrInvalidAlphabet)
+	_, err = Generate("abca", 10)
+	assert.ErrorIs(t, err, ErrInvalidAlphabet)
+	_, err = Generate("abc", -1)
+	assert.ErrorIs(t, err, ErrInvalidSize)
+	assert.Panics(t, func() { MustGenerate("", 1) })
+}
+
+func TestCollisionProbability(t *testing.T) {
+	assert.InDelta(t, 5.877e-27, CollisionProbability(len(DefaultAlphabet), DefaultSize, 1e6), 1e-29)
+	assert.InDelta(t, 0.3654, CollisionProbability(len(ShortCodeAlphabet), 8, 1e6), 1e-4)
+	assert.InDelta(t, 0.5, CollisionProbability(365, 1, 23), 0.01)
+	assert.Equal(t, 0.0, CollisionProbability(32, 8, 1))
+	assert.Equal(t, 1.0, CollisionProbability(2, 3, 9))
+	assert.Equal(t, 1.0, CollisionProbability(1, 10, 2))
+
+	assert.Equal(t, 10, SizeFor(len(ShortCodeAlphabet), 1e6, 0.001))
+	assert.LessOrEqual(t, CollisionProbability(len(ShortCodeAlphabet), 10, 1e6), 0.001)
+	assert.Greater(t, CollisionProbability(len(ShortCodeAlphabet), 9, 1e6), 0.001)
+	assert.Equal(t, -1, SizeFor(1, 1e6, 0.001))
+	assert.Equal(t, -1, SizeFor(32, 1e6, 0))
+}
@@ -0,0 +1,242 @@
+package segment
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// segment id generator (leaf-segment), used to generate dense and strictly increasing ids
+// the generator fetches id ranges (segments) of step ids from the segment store, and issues ids from memory
+// when the current segment has been consumed by the preload ratio, the next segment is fetched in the background (double buffer)
+// the step adapts to the throughput, so that a segment lasts about the segment duration
+
+const (
+	defaultStep            int64   = 1000             // default step
+	defaultMaxStep         int64   = 1000000          // default max step
+	defaultPreloadRatio    float64 = 0.1              // default consumed ratio of the current segment to preload the next segment
+	defaultSegmentDuration         = 15 * time.Minute // default duration that a segment is expected to last
+	defaultTimeout                 = 5 * time.Second  // default timeout of allocating a segment
+)
+
+var (
+	// ErrNilStore nil store error.
+	ErrNilStore = errors.New("segment: store should not be nil")
+	// ErrInvalidConfig invalid config error.
+	ErrInvalidConfig = errors.New("segment: invalid config")
+	// ErrInvalidRange invalid range error, the store returns an empty range.
+	ErrInvalidRange = errors.New("segment: store returns an invalid range")
+)
+
+// Config segment generator config.
+type Config struct {
+	Tag             string        // business tag
+	Store           SegmentStore  // segment store
+	Step            int64         // initial step, default is 1000
+	MinStep         int64         // min step, default is the initial step
+	MaxStep         int64         // max step, default is 1000000 or the initial step if larger
+	PreloadRatio    float64       // consumed ratio of the current segment to preload the next segment, default is 0.1
+	SegmentDuration time.Duration // duration that a segment is expected to last, default is 15 minutes
+	Timeout         time.Duration // timeout of allocating a segment, default is 5 seconds
+}
+
+// segment id segment in memory.
+type segment struct {
+	value int64 // next id to issue
+	start int64 // first id
+	end   int64 // end id, exclusive
+}
+
+// Generator segment id generator.
+type Generator struct {
+	mutex *sync.Mutex
+	cond  *sync.Cond
+
+	tag             string
+	store           SegmentStore
+	minStep         int64
+	maxStep         int64
+	preloadRatio    float64
+	segmentDuration time.Duration
+	timeout         time.Duration
+
+	current    *segment  // current segment
+	next       *segment  // next segment, nil if not loaded
+	loading    bool      // whether the next segment is loading
+	loadErr    error     // error of the last loading
+	step       int64     // current step
+	updateTime time.Time // time of the last allocation
+}
+
+// NewGenerator new a segment generator, the first segment is allocated synchronously.
+func NewGenerator(c *Config) (*Generator, error) {
+	if c.Store == nil {
+		return nil, ErrNilStore
+	}
+	if c.Tag == "" {
+		return nil, ErrInvalidTag
+	}
+
+	g := &Generator{
+		mutex:           new(sync.Mutex),
+		tag:             c.Tag,
+		store:           c.Store,
+		step:            c.Step,
+		minStep:         c.MinStep,
+		maxStep:         c.MaxStep,
+		preloadRatio:    c.PreloadRatio,
+		segmentDuration: c.SegmentDuration,
+		timeout:         c.Timeout,
+	}
+	g.cond = sync.NewCond(g.mutex)
+
+	if g.step == 0 {
+		g.step = defaultStep
+	}
+	if g.minStep == 0 {
+		g.minStep = g.step
+	}
+	if g.maxStep == 0 {
+		g.maxStep = defaultMaxStep
+		if g.maxStep < g.step {
+			g.maxStep = g.step
+		}
+	}
+	if g.preloadRatio == 0 {
+		g.preloadRatio = defaultPreloadRatio
+	}
+	if g.segmentDuration == 0 {
+		g.segmentDuration = defaultSegmentDuration
+	}
+	if g.timeout == 0 {
+		g.timeout = defaultTimeout
+	}
+
+	if g.step < 0 || g.minStep <= 0 || g.minStep > g.step || g.maxStep < g.step ||
+		g.preloadRatio < 0 || g.preloadRatio > 1 || g.segmentDuration < 0 || g.timeout < 0 {
+		return nil, ErrInvalidConfig
+	}
+
+	seg, err := g.allocate(g.step)
+	if err != nil {
+		return nil, err
+	}
+	g.current = seg
+	g.updateTime = time.Now()
+
+	return g, nil
+}
+
+// Tag returns the business tag of the generator.
+func (g *Generator) Tag() string {
+	return g.tag
+}
+
+// Step returns the current step of the generator.
+func (g *Generator) Step() int64 {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return g.step
+}
+
+// NextId generates segment id.
+func (g *Generator) NextId() (int64, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	for {
+		cur := g.current
+		if cur.value < cur.end {
+			// preloads the next segment, a failed preloading is retried once the current segment is exhausted
+			if g.next == nil && !g.loading && g.loadErr == nil &&
+				float64(cur.value-cur.start) >= g.preloadRatio*float64(cur.end-cur.start) {
+				g.loading = true
+				go g.loadNext()
+			}
+
+			id := cur.value
+			cur.value++
+			return id, nil
+		}
+
+		if g.next != nil {
+			g.current, g.next = g.next, nil
+			continue
+		}
+
+		if !g.loading {
+			g.loadErr = nil
+			g.loading = true
+			go g.loadNext()
+		}
+
+		for g.loading {
+			g.cond.Wait()
+		}
+		if g.next == nil && g.loadErr != nil && g.current.value >= g.current.end {
+			return 0, g.loadErr
+		}
+	}
+}
+
+// loadNext loads the next segment in the background with the adapted step.
+func (g *Generator) loadNext() {
+	g.mutex.Lock()
+	step := g.adaptStep()
+	g.mutex.Unlock()
+
+	seg, err := g.allocate(step)
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if err != nil {
+		g.loadErr = err
+	} else {
+		g.next = seg
+		g.step = step
+		g.updateTime = time.Now()
+	}
+	g.loading = false
+	g.cond.Broadcast()
+}
+
+// adaptStep adapts the step to the throughput: if the last segment lasts less than
+// the segment duration, the step is doubled, if it lasts more than twice, the step is halved.
+func (g *Generator) adaptStep() int64 {
+	step := g.step
+	elapsed := time.Since(g.updateTime)
+
+	switch {
+	case elapsed < g.segmentDuration:
+		step *= 2
+		if step > g.maxStep {
+			step = g.maxStep
+		}
+	case elapsed >= 2*g.segmentDuration:
+		step /= 2
+		if step < g.minStep {
+			step = g.minStep
+		}
+	}
+
+	return step
+}
+
+// allocate allocates a segment of step ids from the store.
+func (g *Generator) allocate(step int64) (*segment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), g.timeout)
+	defer cancel()
+
+	r, err := g.store.Allocate(ctx, g.tag, step)
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() <= 0 {
+		return nil, ErrInvalidRange
+	}
+
+	return &segment{value: r.Start, start: r.Start, end: r.End}, nil
+}
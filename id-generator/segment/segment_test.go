@@ -0,0 +1,109 @@
+package segment
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGenerator(t *testing.T) {
+	_, err := NewGenerator(&Config{Tag: "order"})
+	assert.ErrorIs(t, err, ErrNilStore)
+	_, err = NewGenerator(&Config{Store: NewMemoryStore()})
+	assert.ErrorIs(t, err, ErrInvalidTag)
+	_, err = NewGenerator(&Config{Tag: "order", Store: NewMemoryStore(), Step: 10, MaxStep: 5})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = NewGenerator(&Config{Tag: "order", Store: NewMemoryStore(), PreloadRatio: 2})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+
+	g, err := NewGenerator(&Config{Tag: "order", Store: NewMemoryStore()})
+	require.NoError(t, err)
+	assert.Equal(t, "order", g.Tag())
+	assert.Equal(t, int64(1000), g.Step())
+}
+
+func TestGenerator_NextId(t *testing.T) {
+	g, err := NewGenerator(&Config{Tag: "order", Store: NewMemoryStore(), Step: 10, MaxStep: 100})
+	require.NoError(t, err)
+
+	last := int64(0)
+	for i := 0; i < 1000; i++ {
+		id, err := g.NextId()
+		require.NoError(t, err)
+		assert.Greater(t, id, last)
+		last = id
+	}
+
+	// segments are consumed fast, so the step grows to the max step
+	assert.Equal(t, int64(100), g.Step())
+}
+
+func TestGenerator_NextId_Concurrent(t *testing.T) {
+	g, err := NewGenerator(&Config{Tag: "order", Store: NewMemoryStore(), Step: 10})
+	require.NoError(t, err)
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+		ids   = make(map[int64]struct{})
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				id, err := g.NextId()
+				if !assert.NoError(t, err) {
+					return
+				}
+				mutex.Lock()
+				ids[id] = struct{}{}
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, ids, 10000)
+}
+
+type failStore struct {
+	SegmentStore
+	fail bool
+}
+
+func (s *failStore) Allocate(ctx context.Context, tag string, step int64) (Range, error) {
+	if s.fail {
+		return Range{}, errors.New("store unavailable")
+	}
+
+	return s.SegmentStore.Allocate(ctx, tag, step)
+}
+
+func TestGenerator_NextId_Error(t *testing.T) {
+	s := &failStore{SegmentStore: NewMemoryStore()}
+	g, err := NewGenerator(&Config{Tag: "order", Store: s, Step: 10})
+	require.NoError(t, err)
+
+	s.fail = true
+	for i := 1; i <= 10; i++ {
+		id, err := g.NextId()
+		require.NoError(t, err)
+		assert.Equal(t, int64(i), id)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	_, err = g.NextId()
+	require.EqualError(t, err, "store unavailable")
+
+	s.fail = false
+	id, err := g.NextId()
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), id)
+}
@@ -0,0 +1,217 @@
+package segment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/internal/flock"
+)
+
+var (
+	// ErrInvalidTag invalid business tag error.
+	ErrInvalidTag = errors.New("segment: invalid tag")
+	// ErrInvalidStep invalid step error.
+	ErrInvalidStep = errors.New("segment: invalid step")
+	// ErrExhausted ids exhausted error, the next range overflows int64.
+	ErrExhausted = errors.New("segment: ids exhausted")
+	// ErrUnsupported file store unsupported error, the flock is only supported on unix platforms.
+	ErrUnsupported = errors.New("segment: file store is not supported on this platform")
+)
+
+// lockRetryInterval retry interval of trying to place the flock.
+const lockRetryInterval = 10 * time.Millisecond
+
+// Range id range [Start, End).
+type Range struct {
+	Start int64 // first id of the range
+	End   int64 // end id of the range, exclusive
+}
+
+// Len returns the number of ids in the range.
+func (r Range) Len() int64 {
+	return r.End - r.Start
+}
+
+// SegmentStore segment store, it allocates id ranges for business tags.
+// Allocate must be atomic across all the generators sharing the store,
+// a SQL store can be implemented with database/sql in a transaction like:
+//
+//	UPDATE segment SET max_id = max_id + ? WHERE tag = ?
+//	SELECT max_id FROM segment WHERE tag = ?
+//
+// and returns the range [max_id - step, max_id).
+type SegmentStore interface {
+	// Allocate allocates the next range of step ids for the business tag.
+	Allocate(ctx context.Context, tag string, step int64) (Range, error)
+}
+
+// MemoryStore in-memory segment store, ids start from 1.
+// It is only suitable for tests and single process usage, because ids restart after restart.
+type MemoryStore struct {
+	mutex *sync.Mutex
+	maxId map[string]int64
+}
+
+// NewMemoryStore new an in-memory segment store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{mutex: new(sync.Mutex), maxId: make(map[string]int64)}
+}
+
+// Allocate allocates the next range of step ids for the business tag.
+func (s *MemoryStore) Allocate(_ context.Context, tag string, step int64) (Range, error) {
+	if tag == "" {
+		return Range{}, ErrInvalidTag
+	}
+	if step <= 0 {
+		return Range{}, ErrInvalidStep
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	start := s.maxId[tag]
+	if start == 0 {
+		start = 1
+	}
+	if start > math.MaxInt64-step {
+		return Range{}, ErrExhausted
+	}
+	s.maxId[tag] = start + step
+
+	return Range{Start: start, End: start + step}, nil
+}
+
+// FileStore file-backed segment store, ids start from 1.
+// The next id of each business tag is kept in the file named tag.seg under the directory,
+// and allocations are serialized by the flock of tag.lock,
+// so that several processes on the same host can share the directory.
+// It is only supported on unix platforms, NewFileStore returns ErrUnsupported on the others.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore new a file-backed segment store by the directory,
+// ErrUnsupported is returned if the flock is not supported on this platform.
+func NewFileStore(dir string) (*FileStore, error) {
+	if !flock.Supported {
+		return nil, ErrUnsupported
+	}
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, err
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Allocate allocates the next range of step ids for the business tag,
+// it waits for the flock held by other allocations until the context is done.
+func (s *FileStore) Allocate(ctx context.Context, tag string, step int64) (Range, error) {
+	if tag == "" || tag != filepath.Base(tag) || strings.HasPrefix(tag, ".") {
+		return Range{}, ErrInvalidTag
+	}
+	if step <= 0 {
+		return Range{}, ErrInvalidStep
+	}
+	if err := ctx.Err(); err != nil {
+		return Range{}, err
+	}
+
+	lf, err := os.OpenFile(filepath.Join(s.dir, tag+".lock"), os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return Range{}, err
+	}
+	defer lf.Close() // closing the lock file also releases the flock
+
+	if err = lockFile(ctx, lf); err != nil {
+		return Range{}, err
+	}
+
+	fileName := filepath.Join(s.dir, tag+".seg")
+	start := int64(1)
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil && !os.IsNotExist(err) {
+		return Range{}, err
+	}
+	if err == nil {
+		start, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil || start < 1 {
+			return Range{}, fmt.Errorf("segment: invalid segment file %s", fileName)
+		}
+	}
+
+	if start > math.MaxInt64-step {
+		return Range{}, ErrExhausted
+	}
+
+	r := Range{Start: start, End: start + step}
+	if err = writeFile(fileName, []byte(strconv.FormatInt(r.End, 10))); err != nil {
+		return Range{}, err
+	}
+
+	return r, nil
+}
+
+// lockFile places the flock on the file, it retries until the flock is placed or the context is done.
+func lockFile(ctx context.Context, f *os.File) error {
+	for {
+		locked, err := flock.TryLock(f)
+		if err != nil || locked {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// writeFile writes data to a temporary file, fsyncs and atomically renames it to the file name.
+func writeFile(fileName string, data []byte) error {
+	dir, base := filepath.Split(fileName)
+	if dir == "" {
+		dir = "."
+	}
+
+	f, err := ioutil.TempFile(dir, base+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	defer os.Remove(tmpName)
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmpName, fileName); err != nil {
+		return err
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	_ = d.Sync()
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package segment
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/internal/flock"
+)
+
+func TestMemoryStore_Allocate(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+
+	r, err := s.Allocate(ctx, "order", 10)
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: 1, End: 11}, r)
+	assert.Equal(t, int64(10), r.Len())
+
+	r, err = s.Allocate(ctx, "order", 5)
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: 11, End: 16}, r)
+
+	r, err = s.Allocate(ctx, "user", 5)
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: 1, End: 6}, r)
+
+	_, err = s.Allocate(ctx, "", 5)
+	assert.ErrorIs(t, err, ErrInvalidTag)
+	_, err = s.Allocate(ctx, "order", 0)
+	assert.ErrorIs(t, err, ErrInvalidStep)
+
+	s.maxId["order"] = math.MaxInt64 - 1
+	_, err = s.Allocate(ctx, "order", 5)
+	assert.ErrorIs(t, err, ErrExhausted)
+}
+
+func TestFileStore_Allocate(t *testing.T) {
+	if !flock.Supported {
+		t.Skip("flock is not supported")
+	}
+
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	s, err := NewFileStore(dir)
+	require.NoError(t, err)
+
+	r, err := s.Allocate(ctx, "order", 10)
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: 1, End: 11}, r)
+
+	r, err = s.Allocate(ctx, "order", 5)
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: 11, End: 16}, r)
+
+	// a new store on the same directory continues the allocation
+	s2, err := NewFileStore(dir)
+	require.NoError(t, err)
+	r, err = s2.Allocate(ctx, "order", 5)
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: 16, End: 21}, r)
+
+	for _, tag := range []string{"", ".order", "a/b", "../order"} {
+		_, err = s.Allocate(ctx, tag, 5)
+		assert.ErrorIs(t, err, ErrInvalidTag, tag)
+	}
+	_, err = s.Allocate(ctx, "order", -1)
+	assert.ErrorIs(t, err, ErrInvalidStep)
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.Allocate(cctx, "order", 5)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// the allocation waits for the flock held by others until the context is done
+	lf, err := os.OpenFile(filepath.Join(dir, "order.lock"), os.O_RDWR, 0o666)
+	require.NoError(t, err)
+	locked, err := flock.TryLock(lf)
+	require.NoError(t, err)
+	require.True(t, locked)
+	tctx, tcancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer tcancel()
+	_, err = s.Allocate(tctx, "order", 5)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NoError(t, lf.Close())
+
+	r, err = s.Allocate(ctx, "order", 5)
+	require.NoError(t, err)
+	assert.Equal(t, Range{Start: 21, End: 26}, r)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "order.seg"), []byte(strconv.FormatInt(math.MaxInt64-1, 10)), 0o666))
+	_, err = s.Allocate(ctx, "order", 5)
+	assert.ErrorIs(t, err, ErrExhausted)
+}
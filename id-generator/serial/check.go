@@ -0,0 +1,118 @@
+package serial
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrNonDigit non-digit character error.
+var ErrNonDigit = errors.New("serial: check digits can only be computed over decimal digits")
+
+// CheckAlgorithm check digit algorithm.
+type CheckAlgorithm uint8
+
+// check digit algorithms.
+const (
+	// CheckNone no check digit.
+	CheckNone CheckAlgorithm = iota
+	// CheckLuhn luhn (mod 10) check digit, it detects any single-digit error
+	// and most transpositions of adjacent digits, e.g. 79927398713.
+	CheckLuhn
+	// CheckISO7064Mod11x10 ISO 7064 MOD 11,10 hybrid check digit,
+	// it detects all single-digit errors and all transpositions of adjacent digits, e.g. 69435151530.
+	CheckISO7064Mod11x10
+	// CheckISO7064Mod97x10 ISO 7064 MOD 97-10 two check digits, the algorithm used by IBAN,
+	// it detects nearly all single and double substitution errors, e.g. 12345676.
+	CheckISO7064Mod97x10
+)
+
+// Len returns the number of check digits.
+func (a CheckAlgorithm) Len() int {
+	switch a {
+	case CheckLuhn, CheckISO7064Mod11x10:
+		return 1
+	case CheckISO7064Mod97x10:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// Compute computes the check digits of the decimal digits.
+func (a CheckAlgorithm) Compute(digits string) (string, error) {
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return "", ErrNonDigit
+		}
+	}
+
+	switch a {
+	case CheckLuhn:
+		return strconv.Itoa(luhn(digits)), nil
+	case CheckISO7064Mod11x10:
+		return strconv.Itoa(mod11x10(digits)), nil
+	case CheckISO7064Mod97x10:
+		c := mod97x10(digits)
+		return string([]byte{byte('0' + c/10), byte('0' + c%10)}), nil
+	default:
+		return "", nil
+	}
+}
+
+// Verify reports whether the decimal digits end with the valid check digits.
+func (a CheckAlgorithm) Verify(digits string) bool {
+	n := a.Len()
+	if len(digits) <= n {
+		return n == 0 && len(digits) != 0
+	}
+
+	c, err := a.Compute(digits[:len(digits)-n])
+	if err != nil {
+		return false
+	}
+
+	return c == digits[len(digits)-n:]
+}
+
+// luhn computes the luhn check digit, every second digit from the right is doubled.
+func luhn(digits string) int {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return (10 - sum%10) % 10
+}
+
+// mod11x10 computes the ISO 7064 MOD 11,10 check digit.
+func mod11x10(digits string) int {
+	p := 10
+	for i := 0; i < len(digits); i++ {
+		s := (p + int(digits[i]-'0')) % 10
+		if s == 0 {
+			s = 10
+		}
+		p = s * 2 % 11
+	}
+
+	return (11 - p) % 10
+}
+
+// mod97x10 computes the ISO 7064 MOD 97-10 check digits.
+func mod97x10(digits string) int {
+	r := 0
+	for i := 0; i < len(digits); i++ {
+		r = (r*10 + int(digits[i]-'0')) % 97
+	}
+
+	return 98 - r*100%97
+}
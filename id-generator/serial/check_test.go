@@ -0,0 +1,40 @@
+package serial
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAlgorithm(t *testing.T) {
+	cases := []struct {
+		algorithm CheckAlgorithm
+		digits    string
+		expect    string
+	}{
+		{algorithm: CheckNone, digits: "123", expect: ""},
+		{algorithm: CheckLuhn, digits: "7992739871", expect: "3"},
+		{algorithm: CheckLuhn, digits: "411111111111111", expect: "1"},
+		{algorithm: CheckISO7064Mod11x10, digits: "6943515153", expect: "0"},
+		{algorithm: CheckISO7064Mod97x10, digits: "123456", expect: "76"},
+	}
+
+	for _, c := range cases {
+		check, err := c.algorithm.Compute(c.digits)
+		require.NoError(t, err)
+		assert.Equal(t, c.expect, check)
+		assert.Len(t, check, c.algorithm.Len())
+		assert.True(t, c.algorithm.Verify(c.digits+check))
+	}
+
+	assert.False(t, CheckLuhn.Verify("79927398710"))
+	assert.False(t, CheckLuhn.Verify("79927398731"))
+	assert.False(t, CheckISO7064Mod11x10.Verify("69435151503"))
+	assert.False(t, CheckISO7064Mod97x10.Verify("12345667"))
+	assert.False(t, CheckISO7064Mod97x10.Verify("76"))
+	assert.False(t, CheckLuhn.Verify("7a3"))
+
+	_, err := CheckLuhn.Compute("12a")
+	assert.ErrorIs(t, err, ErrNonDigit)
+}
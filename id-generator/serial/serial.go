@@ -0,0 +1,414 @@
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sliveryou/go-tool/v2/randx"
+	"github.com/sliveryou/go-tool/v2/timex"
+)
+
+// serial number generator, used to generate business serial numbers like order, refund and invoice numbers
+// the layout is described by a template of literal text and fields, e.g. ORD{date:20060102}{node:2}{seq:6}{rand:2}
+// {date:layout}: the generate time formatted by the go time layout, default layout is 20060102
+// {node:width}: the node id zero-padded to the width, default width is 2
+// {seq:width}: the sequence zero-padded to the width, default width is 6, it starts from 1 and resets when the date changes
+// {rand:width}: random decimal digits of the width, default width is 4
+// the check digits are appended to the end, they are computed over the decimal digits of the serial number
+
+const (
+	defaultTemplate   = "{date:20060102}{node:2}{seq:6}" // default template
+	defaultDateLayout = "20060102"                       // default date layout
+	defaultNodeWidth  = 2                                // default node id width
+	defaultSeqWidth   = 6                                // default sequence width
+	defaultRandWidth  = 4                                // default random digits width
+	maxFieldWidth     = 18                               // max width of numeric fields
+	defaultReserve    = 100                              // default number of sequences reserved by each save
+)
+
+var (
+	// ErrInvalidTemplate invalid template error.
+	ErrInvalidTemplate = errors.New("serial: invalid template")
+	// ErrInvalidNodeId invalid node id error.
+	ErrInvalidNodeId = errors.New("serial: invalid node id")
+	// ErrSequenceOverflow sequence overflow error, too many serial numbers are generated in one date.
+	ErrSequenceOverflow = errors.New("serial: sequence overflow")
+	// ErrInvalidSerial invalid serial number error.
+	ErrInvalidSerial = errors.New("serial: invalid serial number")
+	// ErrInvalidCheck invalid check digits error.
+	ErrInvalidCheck = errors.New("serial: invalid check digits")
+)
+
+// Config serial number generator config.
+type Config struct {
+	Template string                // layout template, default is {date:20060102}{node:2}{seq:6}
+	NodeId   func() (int64, error) // node id, required if the template has the node field
+	Location *time.Location        // location of the date, default is Asia/Shanghai
+	Check    CheckAlgorithm        // check digit algorithm, default is CheckNone
+	Store    SequenceStore         // sequence store, the sequence is only kept in memory if it is nil
+	Reserve  int64                 // number of sequences reserved by each save of the store, default is 100
+}
+
+// Parts parsed parts of a serial number.
+type Parts struct {
+	Time     time.Time // generate time truncated to the date layout, zero if the template has no date field
+	Node     int64     // node id
+	Sequence int64     // sequence
+	Random   string    // random digits
+	Check    string    // check digits
+}
+
+// field kinds.
+const (
+	kindLiteral = iota
+	kindDate
+	kindNode
+	kindSeq
+	kindRand
+)
+
+// field template field.
+type field struct {
+	kind   int    // field kind
+	text   string // literal text or date layout
+	width  int    // width of the rendered field
+	maxVal int64  // max value of numeric fields
+}
+
+// Generator serial number generator.
+// The sequence is kept in memory unless Config.Store is set, so a generator without the store
+// issues the same serial numbers again if it restarts on the same date, and should only be used
+// when the template has the rand field or the serial numbers are not required to be unique across restarts.
+// With the store, the sequences are reserved in batches of Config.Reserve,
+// and the unused sequences reserved before the restart are skipped.
+type Generator struct {
+	mutex    *sync.Mutex      // mutex, used to ensure concurrency security
+	fields   []field          // template fields
+	location *time.Location   // location of the date
+	check    CheckAlgorithm   // check digit algorithm
+	nodeId   int64            // node id
+	now      func() time.Time // now function
+	store    SequenceStore    // sequence store
+	reserve  int64            // number of sequences reserved by each save
+	reserved int64            // last reserved sequence of the last date
+
+	lastTime time.Time // last generate time
+	lastDate string    // last rendered date
+	sequence int64     // last sequence
+}
+
+// MustNewGenerator must new a serial number generator.
+func MustNewGenerator(c *Config) *Generator {
+	g, err := NewGenerator(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+// NewGenerator new a serial number generator.
+func NewGenerator(c *Config) (*Generator, error) {
+	template := c.Template
+	if template == "" {
+		template = defaultTemplate
+	}
+	fields, err := parseTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	if c.Check > CheckISO7064Mod97x10 {
+		return nil, fmt.Errorf("serial: unsupported check algorithm %d", c.Check)
+	}
+
+	g := &Generator{
+		mutex:    new(sync.Mutex),
+		fields:   fields,
+		location: c.Location,
+		check:    c.Check,
+		now:      time.Now,
+		store:    c.Store,
+		reserve:  c.Reserve,
+	}
+	if g.reserve <= 0 {
+		g.reserve = defaultReserve
+	}
+	if g.location == nil {
+		g.location = timex.Shanghai()
+	}
+
+	for _, f := range fields {
+		if f.kind != kindNode {
+			continue
+		}
+		if c.NodeId == nil {
+			return nil, ErrInvalidNodeId
+		}
+		nodeId, err := c.NodeId()
+		if err != nil {
+			return nil, err
+		}
+		if nodeId < 0 || nodeId > f.maxVal {
+			return nil, ErrInvalidNodeId
+		}
+		g.nodeId = nodeId
+	}
+
+	return g, nil
+}
+
+// Next generates serial number.
+func (g *Generator) Next() (string, error) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	t := g.now()
+	if t.Before(g.lastTime) {
+		// the clock moves backwards, keeps the last time to avoid resetting the sequence
+		t = g.lastTime
+	}
+
+	date := ""
+	for _, f := range g.fields {
+		if f.kind == kindDate {
+			date = timex.Format(t, f.text, g.location)
+			break
+		}
+	}
+
+	sequence, reserved := g.sequence+1, g.reserved
+	if date != g.lastDate || g.lastTime.IsZero() {
+		sequence, reserved = 1, 0
+		if g.store != nil {
+			last, err := g.store.Load(date)
+			if err != nil {
+				return "", err
+			}
+			sequence, reserved = last+1, last
+		}
+	}
+	for _, f := range g.fields {
+		if f.kind == kindSeq && sequence > f.maxVal {
+			return "", ErrSequenceOverflow
+		}
+	}
+	if g.store != nil && sequence > reserved {
+		reserved = sequence + g.reserve - 1
+		if err := g.store.Save(date, reserved); err != nil {
+			return "", err
+		}
+	}
+	g.lastTime, g.lastDate, g.sequence, g.reserved = t, date, sequence, reserved
+
+	var sb strings.Builder
+	for _, f := range g.fields {
+		switch f.kind {
+		case kindLiteral:
+			sb.WriteString(f.text)
+		case kindDate:
+			sb.WriteString(date)
+		case kindNode:
+			sb.WriteString(pad(g.nodeId, f.width))
+		case kindSeq:
+			sb.WriteString(pad(sequence, f.width))
+		case kindRand:
+			sb.WriteString(randx.NewNumber(f.width))
+		}
+	}
+
+	s := sb.String()
+	c, err := g.check.Compute(digitsOf(s))
+	if err != nil {
+		return "", err
+	}
+
+	return s + c, nil
+}
+
+// Parse parses the serial number generated by the generator into parts,
+// it verifies the literal text, the numeric fields and the check digits.
+func (g *Generator) Parse(serial string) (*Parts, error) {
+	n := g.check.Len()
+	if len(serial) < n {
+		return nil, ErrInvalidSerial
+	}
+	s, check := serial[:len(serial)-n], serial[len(serial)-n:]
+
+	p := &Parts{Check: check}
+	for _, f := range g.fields {
+		if len(s) < f.width {
+			return nil, ErrInvalidSerial
+		}
+		v := s[:f.width]
+		s = s[f.width:]
+
+		switch f.kind {
+		case kindLiteral:
+			if v != f.text {
+				return nil, ErrInvalidSerial
+			}
+		case kindDate:
+			t, err := time.ParseInLocation(f.text, v, g.location)
+			if err != nil {
+				return nil, ErrInvalidSerial
+			}
+			p.Time = t
+		case kindNode, kindSeq, kindRand:
+			if !isDigits(v) {
+				return nil, ErrInvalidSerial
+			}
+			if f.kind == kindRand {
+				p.Random = v
+				continue
+			}
+			i, _ := strconv.ParseInt(v, 10, 64)
+			if f.kind == kindNode {
+				p.Node = i
+			} else {
+				p.Sequence = i
+			}
+		}
+	}
+	if s != "" {
+		return nil, ErrInvalidSerial
+	}
+
+	if n != 0 {
+		c, err := g.check.Compute(digitsOf(serial[:len(serial)-n]))
+		if err != nil || c != check {
+			return nil, ErrInvalidCheck
+		}
+	}
+
+	return p, nil
+}
+
+// parseTemplate parses the template into fields.
+func parseTemplate(template string) ([]field, error) {
+	var fields []field
+	seen := make(map[int]bool)
+
+	for template != "" {
+		i := strings.IndexAny(template, "{}")
+		if i < 0 {
+			fields = append(fields, field{kind: kindLiteral, text: template, width: len(template)})
+			break
+		}
+		if template[i] == '}' {
+			return nil, ErrInvalidTemplate
+		}
+		if i > 0 {
+			fields = append(fields, field{kind: kindLiteral, text: template[:i], width: i})
+		}
+
+		j := strings.IndexByte(template[i:], '}')
+		if j < 0 {
+			return nil, ErrInvalidTemplate
+		}
+		f, err := parseField(template[i+1 : i+j])
+		if err != nil {
+			return nil, err
+		}
+		if seen[f.kind] {
+			return nil, fmt.Errorf("%w: duplicate field %s", ErrInvalidTemplate, template[i:i+j+1])
+		}
+		seen[f.kind] = true
+		fields = append(fields, f)
+		template = template[i+j+1:]
+	}
+
+	if len(fields) == 0 {
+		return nil, ErrInvalidTemplate
+	}
+
+	return fields, nil
+}
+
+// parseField parses the field like name:arg.
+func parseField(s string) (field, error) {
+	name, arg, hasArg := strings.Cut(s, ":")
+
+	if name == "date" {
+		layout := defaultDateLayout
+		if hasArg {
+			layout = arg
+		}
+		// the date must be rendered in a fixed width so that it can be parsed back
+		t1 := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC).Format(layout)
+		t2 := time.Date(2026, 12, 31, 23, 59, 59, 999999999, time.UTC).Format(layout)
+		if layout == "" || strings.ContainsAny(layout, "{}") || len(t1) != len(t2) {
+			return field{}, fmt.Errorf("%w: variable width date layout %s", ErrInvalidTemplate, layout)
+		}
+		return field{kind: kindDate, text: layout, width: len(t1)}, nil
+	}
+
+	var f field
+	switch name {
+	case "node":
+		f = field{kind: kindNode, width: defaultNodeWidth}
+	case "seq":
+		f = field{kind: kindSeq, width: defaultSeqWidth}
+	case "rand":
+		f = field{kind: kindRand, width: defaultRandWidth}
+	default:
+		return field{}, fmt.Errorf("%w: unknown field %s", ErrInvalidTemplate, s)
+	}
+
+	if hasArg {
+		w, err := strconv.Atoi(arg)
+		if err != nil || w < 1 || w > maxFieldWidth {
+			return field{}, fmt.Errorf("%w: invalid width of field %s", ErrInvalidTemplate, s)
+		}
+		f.width = w
+	}
+	f.maxVal = pow10(f.width) - 1
+
+	return f, nil
+}
+
+// pad returns the zero-padded decimal string of the number.
+func pad(i int64, width int) string {
+	s := strconv.FormatInt(i, 10)
+	if len(s) < width {
+		s = strings.Repeat("0", width-len(s)) + s
+	}
+
+	return s
+}
+
+// pow10 returns 10^n.
+func pow10(n int) int64 {
+	p := int64(1)
+	for i := 0; i < n; i++ {
+		p *= 10
+	}
+
+	return p
+}
+
+// digitsOf returns the decimal digits of the string.
+func digitsOf(s string) string {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			b = append(b, s[i])
+		}
+	}
+
+	return string(b)
+}
+
+// isDigits reports whether the string consists of decimal digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
@@ -0,0 +1,161 @@
+package serial
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGenerator(t *testing.T) {
+	cases := []string{
+		"{date",
+		"ORD}",
+		"{unknown}",
+		"{seq:0}",
+		"{seq:19}",
+		"{seq:x}",
+		"{date:Monday}",
+		"{seq}{seq}",
+	}
+	for _, c := range cases {
+		_, err := NewGenerator(&Config{Template: c})
+		assert.ErrorIs(t, err, ErrInvalidTemplate, c)
+	}
+
+	_, err := NewGenerator(&Config{})
+	assert.ErrorIs(t, err, ErrInvalidNodeId)
+	_, err = NewGenerator(&Config{NodeId: func() (int64, error) { return 100, nil }})
+	assert.ErrorIs(t, err, ErrInvalidNodeId)
+
+	g, err := NewGenerator(&Config{NodeId: func() (int64, error) { return 99, nil }})
+	require.NoError(t, err)
+	s, err := g.Next()
+	require.NoError(t, err)
+	assert.Len(t, s, 16)
+	assert.Regexp(t, `^\d{8}99000001$`, s)
+}
+
+func TestGenerator_Next(t *testing.T) {
+	now := time.Date(2026, 10, 18, 23, 59, 59, 0, time.UTC)
+	g, err := NewGenerator(&Config{
+		Template: "ORD{date:060102}{node:2}{seq:4}{rand:3}",
+		NodeId:   func() (int64, error) { return 7, nil },
+		Location: time.UTC,
+		Check:    CheckLuhn,
+	})
+	require.NoError(t, err)
+	g.now = func() time.Time { return now }
+
+	s, err := g.Next()
+	require.NoError(t, err)
+	assert.Regexp(t, `^ORD261018070001\d{4}$`, s)
+	assert.True(t, CheckLuhn.Verify(digitsOf(s)))
+
+	s, err = g.Next()
+	require.NoError(t, err)
+	assert.Regexp(t, `^ORD261018070002\d{4}$`, s)
+
+	// the clock moves backwards, the sequence is not reset
+	now = now.Add(-time.Hour)
+	s, err = g.Next()
+	require.NoError(t, err)
+	assert.Regexp(t, `^ORD261018070003\d{4}$`, s)
+
+	// the sequence resets when the date changes
+	now = now.Add(2 * time.Hour)
+	s, err = g.Next()
+	require.NoError(t, err)
+	assert.Regexp(t, `^ORD261019070001\d{4}$`, s)
+
+	p, err := g.Parse(s)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), p.Time)
+	assert.Equal(t, int64(7), p.Node)
+	assert.Equal(t, int64(1), p.Sequence)
+	assert.Len(t, p.Random, 3)
+	assert.Equal(t, s[len(s)-1:], p.Check)
+}
+
+func TestGenerator_Next_Overflow(t *testing.T) {
+	g, err := NewGenerator(&Config{Template: "{date}{seq:1}"})
+	require.NoError(t, err)
+	now := time.Now()
+	g.now = func() time.Time { return now }
+
+	for i := 0; i < 9; i++ {
+		_, err = g.Next()
+		require.NoError(t, err)
+	}
+	_, err = g.Next()
+	assert.ErrorIs(t, err, ErrSequenceOverflow)
+}
+
+func TestGenerator_Next_Concurrent(t *testing.T) {
+	g, err := NewGenerator(&Config{Template: "{date}{seq:8}", Check: CheckISO7064Mod97x10})
+	require.NoError(t, err)
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+		set   = make(map[string]struct{})
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s, err := g.Next()
+				if !assert.NoError(t, err) {
+					return
+				}
+				mutex.Lock()
+				set[s] = struct{}{}
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, set, 1000)
+}
+
+func TestGenerator_Parse(t *testing.T) {
+	g := MustNewGenerator(&Config{
+		Template: "INV-{date:2006}-{seq:5}",
+		Location: time.UTC,
+		Check:    CheckISO7064Mod11x10,
+	})
+
+	s, err := g.Next()
+	require.NoError(t, err)
+	p, err := g.Parse(s)
+	require.NoError(t, err)
+	assert.Equal(t, time.Now().UTC().Year(), p.Time.Year())
+	assert.Equal(t, int64(1), p.Sequence)
+
+	check, err := CheckISO7064Mod11x10.Compute("202600001")
+	require.NoError(t, err)
+	cases := []struct {
+		serial string
+		err    error
+	}{
+		{serial: "INV-2026-00001" + check, err: nil},
+		{serial: "INV-2026-00002" + check, err: ErrInvalidCheck},
+		{serial: "ORD-2026-00001" + check, err: ErrInvalidSerial},
+		{serial: "INV-2026-0000a" + check, err: ErrInvalidSerial},
+		{serial: "INV-2026-00001", err: ErrInvalidSerial},
+		{serial: "INV-2026-000011" + check, err: ErrInvalidSerial},
+		{serial: "", err: ErrInvalidSerial},
+	}
+	for _, c := range cases {
+		_, err := g.Parse(c.serial)
+		if c.err == nil {
+			assert.NoError(t, err, c.serial)
+		} else {
+			assert.ErrorIs(t, err, c.err, c.serial)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package serial
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidSequenceFile invalid sequence file error.
+var ErrInvalidSequenceFile = errors.New("serial: invalid sequence file")
+
+// SequenceStore persists the reserved sequence of the date, so that a restarted generator
+// continues the sequence of the same date instead of issuing the same serial numbers again.
+type SequenceStore interface {
+	// Load loads the last reserved sequence of the date, 0 if the date has no sequence.
+	Load(date string) (int64, error)
+	// Save saves the reserved sequence of the date.
+	Save(date string, sequence int64) error
+}
+
+// SequenceFile persists the reserved sequence of the latest date to a local file,
+// the file contains the date and the sequence separated by a space, e.g. 20261018 100.
+type SequenceFile struct {
+	mutex    *sync.Mutex // mutex, used to ensure concurrency security
+	fileName string      // file name
+}
+
+// NewSequenceFile new a sequence file by file name.
+func NewSequenceFile(fileName string) *SequenceFile {
+	return &SequenceFile{mutex: new(sync.Mutex), fileName: fileName}
+}
+
+// Load loads the last reserved sequence of the date,
+// it returns 0 if the sequence file does not exist or is saved for another date.
+func (sf *SequenceFile) Load(date string) (int64, error) {
+	sf.mutex.Lock()
+	defer sf.mutex.Unlock()
+
+	data, err := ioutil.ReadFile(sf.fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) == 1 {
+		// the template has no date field
+		fields = append([]string{""}, fields...)
+	}
+	if len(fields) != 2 {
+		return 0, ErrInvalidSequenceFile
+	}
+	sequence, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil || sequence < 0 {
+		return 0, ErrInvalidSequenceFile
+	}
+	if fields[0] != date {
+		return 0, nil
+	}
+
+	return sequence, nil
+}
+
+// Save saves the reserved sequence of the date.
+// The data is written to a temporary file, fsync'd and atomically renamed,
+// so that a crash never leaves a partially written sequence file.
+func (sf *SequenceFile) Save(date string, sequence int64) error {
+	sf.mutex.Lock()
+	defer sf.mutex.Unlock()
+
+	dir, base := filepath.Split(sf.fileName)
+	if dir == "" {
+		dir = "."
+	}
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(dir, base+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	defer os.Remove(tmpName)
+
+	_, err = f.WriteString(strings.TrimSpace(date + " " + strconv.FormatInt(sequence, 10)))
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpName, sf.fileName)
+}
@@ -0,0 +1,77 @@
+package serial
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "sub", "serial.seq")
+	sf := NewSequenceFile(fileName)
+
+	seq, err := sf.Load("20261018")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), seq)
+
+	require.NoError(t, sf.Save("20261018", 100))
+	seq, err = sf.Load("20261018")
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), seq)
+	seq, err = sf.Load("20261019")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), seq)
+
+	require.NoError(t, sf.Save("", 5))
+	seq, err = sf.Load("")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), seq)
+
+	entries, err := ioutil.ReadDir(filepath.Dir(fileName))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, ioutil.WriteFile(fileName, []byte("20261018 bad"), 0o666))
+	_, err = sf.Load("20261018")
+	require.ErrorIs(t, err, ErrInvalidSequenceFile)
+}
+
+func TestGenerator_Next_Store(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	sf := NewSequenceFile(filepath.Join(t.TempDir(), "serial.seq"))
+	newGenerator := func() *Generator {
+		g, err := NewGenerator(&Config{Template: "{date}{seq:6}", Location: time.UTC, Store: sf, Reserve: 10})
+		require.NoError(t, err)
+		g.now = func() time.Time { return now }
+		return g
+	}
+
+	g := newGenerator()
+	for i := 1; i <= 12; i++ {
+		p, err := g.Parse(mustNext(t, g))
+		require.NoError(t, err)
+		assert.Equal(t, int64(i), p.Sequence)
+	}
+	seq, err := sf.Load("20261018")
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), seq)
+
+	// the restarted generator skips the unused reserved sequences
+	g = newGenerator()
+	assert.Equal(t, "20261018000021", mustNext(t, g))
+
+	now = now.Add(24 * time.Hour)
+	assert.Equal(t, "20261019000001", mustNext(t, g))
+}
+
+func mustNext(t *testing.T, g *Generator) string {
+	t.Helper()
+
+	s, err := g.Next()
+	require.NoError(t, err)
+	return s
+}
@@ -0,0 +1,164 @@
+package snowflake
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/base58"
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/base62"
+)
+
+// ErrInvalidId invalid id error.
+var ErrInvalidId = errors.New("invalid id")
+
+// ID snowflake id.
+// It is marshalled to JSON as a string, because int64 ids exceed
+// the safe integer range of JavaScript, and it can be unmarshalled
+// from both JSON string and number.
+type ID int64
+
+// NextID generates snowflake id as ID.
+func (s *Snowflake) NextID() (ID, error) {
+	id, err := s.NextId()
+	return ID(id), err
+}
+
+// ParseID parses decimal string to ID.
+func ParseID(id string) (ID, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || i < 0 {
+		return 0, ErrInvalidId
+	}
+
+	return ID(i), nil
+}
+
+// ParseBase58 parses base58 string to ID.
+func ParseBase58(id string) (ID, error) {
+	i, err := base58.StdEncoding.Decode(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return ID(i), nil
+}
+
+// ParseBase62 parses base62 string to ID.
+func ParseBase62(id string) (ID, error) {
+	i, err := base62.StdEncoding.Decode(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return ID(i), nil
+}
+
+// Int64 returns the int64 value of ID.
+func (id ID) Int64() int64 {
+	return int64(id)
+}
+
+// Time returns the generate time of ID, default start time is 2020-06-01 00:00:00 UTC/GMT +8.00.
+func (id ID) Time(startTime ...time.Time) time.Time {
+	st := defaultStartTime
+	if len(startTime) != 0 {
+		st = unixMilli(startTime[0])
+	}
+
+	return unixMilliTime(st + int64(id)>>timestampShift)
+}
+
+// Node returns the node id of ID.
+func (id ID) Node() int64 {
+	return int64(id) >> nodeShift & nodeMax
+}
+
+// Sequence returns the sequence id of ID.
+func (id ID) Sequence() int64 {
+	return int64(id) & sequenceMax
+}
+
+// String returns the decimal string of ID.
+func (id ID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+// Base58 returns the base58 string of ID.
+func (id ID) Base58() string {
+	return base58.StdEncoding.Encode(int64(id))
+}
+
+// Base62 returns the base62 string of ID.
+func (id ID) Base62() string {
+	return base62.StdEncoding.Encode(int64(id))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (id ID) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, 22)
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(id), 10)
+	b = append(b, '"')
+
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	s := string(data)
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+
+	i, err := ParseID(s)
+	if err != nil {
+		return fmt.Errorf("snowflake: invalid id json %s", data)
+	}
+	*id = i
+
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (id *ID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*id = 0
+		return nil
+	case int64:
+		if v < 0 {
+			return ErrInvalidId
+		}
+		*id = ID(v)
+		return nil
+	case []byte:
+		i, err := ParseID(string(v))
+		if err != nil {
+			return err
+		}
+		*id = i
+		return nil
+	case string:
+		i, err := ParseID(v)
+		if err != nil {
+			return err
+		}
+		*id = i
+		return nil
+	default:
+		return fmt.Errorf("snowflake: unsupported scan type %T", src)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+func (id ID) Value() (driver.Value, error) {
+	return int64(id), nil
+}
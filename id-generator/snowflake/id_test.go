@@ -0,0 +1,94 @@
+package snowflake
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestID(t *testing.T) {
+	s, err := NewSnowflake(&Config{NodeId: NodeId(5)})
+	require.NoError(t, err)
+
+	id, err := s.NextID()
+	require.NoError(t, err)
+
+	p := Parse(id.Int64())
+	assert.Equal(t, p["nodeId"], id.Node())
+	assert.Equal(t, p["sequenceId"], id.Sequence())
+	assert.Equal(t, p["generateTime"], unixMilli(id.Time()))
+	assert.Equal(t, int64(5), id.Node())
+	assert.WithinDuration(t, time.Now(), id.Time(), time.Second)
+
+	st := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, Parse(id.Int64(), st)["generateTime"], unixMilli(id.Time(st)))
+
+	id2, err := ParseID(id.String())
+	require.NoError(t, err)
+	assert.Equal(t, id, id2)
+
+	id2, err = ParseBase58(id.Base58())
+	require.NoError(t, err)
+	assert.Equal(t, id, id2)
+
+	id2, err = ParseBase62(id.Base62())
+	require.NoError(t, err)
+	assert.Equal(t, id, id2)
+
+	_, err = ParseID("-1")
+	require.EqualError(t, err, "invalid id")
+	_, err = ParseID("abc")
+	require.EqualError(t, err, "invalid id")
+}
+
+func TestID_JSON(t *testing.T) {
+	type order struct {
+		Id  ID  `json:"id"`
+		Ref *ID `json:"ref"`
+	}
+
+	o := order{Id: 9007199254740993}
+	b, err := json.Marshal(o)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"9007199254740993","ref":null}`, string(b))
+
+	var o2 order
+	require.NoError(t, json.Unmarshal(b, &o2))
+	assert.Equal(t, o, o2)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id":123,"ref":"456"}`), &o2))
+	assert.Equal(t, ID(123), o2.Id)
+	assert.Equal(t, ID(456), *o2.Ref)
+
+	err = json.Unmarshal([]byte(`{"id":"abc"}`), &o2)
+	require.EqualError(t, err, `snowflake: invalid id json "abc"`)
+}
+
+func TestID_SQL(t *testing.T) {
+	var id ID
+	v, err := ID(123).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(123), v)
+
+	cases := []struct {
+		src    any
+		expect ID
+	}{
+		{src: nil, expect: 0},
+		{src: int64(123), expect: 123},
+		{src: []byte("456"), expect: 456},
+		{src: "789", expect: 789},
+	}
+
+	for _, c := range cases {
+		require.NoError(t, id.Scan(c.src))
+		assert.Equal(t, c.expect, id)
+	}
+
+	require.EqualError(t, id.Scan(1.5), "snowflake: unsupported scan type float64")
+	require.EqualError(t, id.Scan("abc"), "invalid id")
+	require.ErrorIs(t, id.Scan(int64(-1)), ErrInvalidId)
+}
@@ -0,0 +1,93 @@
+package snowflake
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/internal/flock"
+)
+
+var (
+	// ErrNoNodeIdAvailable no node id available error.
+	ErrNoNodeIdAvailable = errors.New("no node id available")
+	// ErrNodeLeaseReleased node lease released error.
+	ErrNodeLeaseReleased = errors.New("node lease released")
+)
+
+// NodeLease leases a node id from a directory of lock files,
+// several processes on the same host leasing from the same directory get distinct node ids.
+// The lease is held until Release is called or the process exits.
+type NodeLease struct {
+	mutex  *sync.Mutex // mutex, used to ensure concurrency security
+	file   *os.File    // locked file, nil after released
+	nodeId int64       // leased node id
+}
+
+// LeaseNodeId leases the first free node id from the directory,
+// the lock file of node id n is named node-n.lock.
+func LeaseNodeId(dir string) (*NodeLease, error) {
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, err
+	}
+
+	for nodeId := int64(0); nodeId <= nodeMax; nodeId++ {
+		fileName := filepath.Join(dir, fmt.Sprintf("node-%d.lock", nodeId))
+		f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0o666)
+		if err != nil {
+			return nil, err
+		}
+
+		locked, err := flock.TryLock(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		if !locked {
+			f.Close()
+			continue
+		}
+
+		// records the holder pid for diagnosis, failures do not affect the lease
+		if err = f.Truncate(0); err == nil {
+			_, _ = f.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0)
+		}
+
+		return &NodeLease{mutex: new(sync.Mutex), file: f, nodeId: nodeId}, nil
+	}
+
+	return nil, ErrNoNodeIdAvailable
+}
+
+// NodeId returns the leased node id, it can be used as Config.NodeId.
+func (l *NodeLease) NodeId() (int64, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.file == nil {
+		return 0, ErrNodeLeaseReleased
+	}
+
+	return l.nodeId, nil
+}
+
+// Release releases the leased node id, so that it can be leased by other processes.
+func (l *NodeLease) Release() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	err := flock.Unlock(l.file)
+	if cerr := l.file.Close(); err == nil {
+		err = cerr
+	}
+	l.file = nil
+
+	return err
+}
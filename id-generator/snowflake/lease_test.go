@@ -0,0 +1,46 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaseNodeId(t *testing.T) {
+	dir := t.TempDir()
+
+	l1, err := LeaseNodeId(dir)
+	require.NoError(t, err)
+	l2, err := LeaseNodeId(dir)
+	require.NoError(t, err)
+
+	id1, err := l1.NodeId()
+	require.NoError(t, err)
+	id2, err := l2.NodeId()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), id1)
+	assert.Equal(t, int64(1), id2)
+
+	s, err := NewSnowflake(&Config{NodeId: l2.NodeId})
+	require.NoError(t, err)
+	id, err := s.NextId()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), Parse(id)["nodeId"])
+
+	require.NoError(t, l1.Release())
+	require.NoError(t, l1.Release())
+	_, err = l1.NodeId()
+	require.EqualError(t, err, "node lease released")
+
+	l3, err := LeaseNodeId(dir)
+	require.NoError(t, err)
+	id3, err := l3.NodeId()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), id3)
+
+	require.NoError(t, l2.Release())
+	require.NoError(t, l3.Release())
+}
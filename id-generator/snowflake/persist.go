@@ -0,0 +1,148 @@
+package snowflake
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrInvalidTimeFile invalid time file error.
+var ErrInvalidTimeFile = errors.New("invalid time file")
+
+// TimeFile persists the last generate time of a snowflake generator to a local file,
+// so that it can be restored on restart by Config.LastGenerateTime.
+type TimeFile struct {
+	mutex    *sync.Mutex // mutex, used to ensure concurrency security
+	fileName string      // file name
+}
+
+// NewTimeFile new a time file by file name.
+func NewTimeFile(fileName string) *TimeFile {
+	return &TimeFile{mutex: new(sync.Mutex), fileName: fileName}
+}
+
+// Load loads the last generate time from the time file,
+// it returns the zero time if the time file does not exist.
+// It can be used as Config.LastGenerateTime.
+func (tf *TimeFile) Load() (time.Time, error) {
+	tf.mutex.Lock()
+	defer tf.mutex.Unlock()
+
+	data, err := ioutil.ReadFile(tf.fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+
+	millis, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil || millis < 0 {
+		return time.Time{}, ErrInvalidTimeFile
+	}
+
+	return unixMilliTime(millis), nil
+}
+
+// Save saves the generate time to the time file.
+// The data is written to a temporary file, fsync'd and atomically renamed,
+// so that a crash never leaves a partially written time file.
+func (tf *TimeFile) Save(t time.Time) error {
+	if t.IsZero() {
+		return nil
+	}
+
+	tf.mutex.Lock()
+	defer tf.mutex.Unlock()
+
+	dir, base := filepath.Split(tf.fileName)
+	if dir == "" {
+		dir = "."
+	}
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(dir, base+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	defer os.Remove(tmpName)
+
+	_, err = f.WriteString(strconv.FormatInt(unixMilli(t), 10))
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmpName, tf.fileName); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// Persist periodically saves the last generate time of the snowflake generator
+// to the time file with a period specified by the duration, and saves it once more when the context is done.
+// It returns the wait function, which waits for the final save and returns its error,
+// it should be called after the context is done and before the process exits, e.g. cancel(); err := wait().
+// The errors of the periodic saving are passed to the optional onError function, e.g. to log or alert them.
+// The periodic saving is disabled if the duration is not positive, and the restored time
+// may lag behind the real last generate time by at most one period only if the process crashes.
+func (tf *TimeFile) Persist(ctx context.Context, s *Snowflake, d time.Duration, onError ...func(err error)) (wait func() error) {
+	done := make(chan struct{})
+	var err error
+	go func() {
+		defer close(done)
+
+		var tick <-chan time.Time
+		if d > 0 {
+			t := time.NewTicker(d)
+			defer t.Stop()
+			tick = t.C
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				err = tf.Save(s.LastGenerateTime())
+				return
+			case <-tick:
+				if err := tf.Save(s.LastGenerateTime()); err != nil && len(onError) > 0 && onError[0] != nil {
+					onError[0](err)
+				}
+			}
+		}
+	}()
+
+	return func() error {
+		<-done
+		return err
+	}
+}
+
+// syncDir fsyncs the directory to make the rename durable,
+// errors are ignored on platforms that do not support syncing directories.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	_ = d.Sync()
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package snowflake
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "sub", "snowflake.time")
+	tf := NewTimeFile(fileName)
+
+	lt, err := tf.Load()
+	require.NoError(t, err)
+	assert.True(t, lt.IsZero())
+
+	require.NoError(t, tf.Save(time.Time{}))
+	lt, err = tf.Load()
+	require.NoError(t, err)
+	assert.True(t, lt.IsZero())
+
+	now := time.Now()
+	require.NoError(t, tf.Save(now))
+	lt, err = tf.Load()
+	require.NoError(t, err)
+	assert.Equal(t, unixMilli(now), unixMilli(lt))
+
+	entries, err := ioutil.ReadDir(filepath.Dir(fileName))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, ioutil.WriteFile(fileName, []byte("bad"), 0o666))
+	_, err = tf.Load()
+	require.EqualError(t, err, "invalid time file")
+}
+
+func TestTimeFile_Persist(t *testing.T) {
+	tf := NewTimeFile(filepath.Join(t.TempDir(), "snowflake.time"))
+
+	s, err := NewSnowflake(&Config{NodeId: NodeId(1), LastGenerateTime: tf.Load})
+	require.NoError(t, err)
+	assert.True(t, s.LastGenerateTime().IsZero())
+
+	_, err = s.NextId()
+	require.NoError(t, err)
+	last := s.LastGenerateTime()
+	assert.False(t, last.IsZero())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wait := tf.Persist(ctx, s, time.Hour)
+	cancel()
+	require.NoError(t, wait())
+
+	lt, err := tf.Load()
+	require.NoError(t, err)
+	assert.Equal(t, unixMilli(last), unixMilli(lt))
+
+	s2, err := NewSnowflake(&Config{NodeId: NodeId(1), LastGenerateTime: tf.Load})
+	require.NoError(t, err)
+	assert.Equal(t, unixMilli(last), unixMilli(s2.LastGenerateTime()))
+}
+
+func TestTimeFile_PersistPeriodically(t *testing.T) {
+	tf := NewTimeFile(filepath.Join(t.TempDir(), "snowflake.time"))
+
+	s, err := NewSnowflake(&Config{NodeId: NodeId(1)})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wait := tf.Persist(ctx, s, 5*time.Millisecond)
+
+	_, err = s.NextId()
+	require.NoError(t, err)
+	last := s.LastGenerateTime()
+	assert.Eventually(t, func() bool {
+		lt, err := tf.Load()
+		return err == nil && lt.Equal(last)
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	require.NoError(t, wait())
+}
+
+func TestTimeFile_PersistError(t *testing.T) {
+	dir := t.TempDir()
+	// the parent of the time file is a regular file, so saving always fails
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "file"), nil, 0o666))
+	tf := NewTimeFile(filepath.Join(dir, "file", "snowflake.time"))
+
+	s, err := NewSnowflake(&Config{NodeId: NodeId(1)})
+	require.NoError(t, err)
+	_, err = s.NextId()
+	require.NoError(t, err)
+
+	errs := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	wait := tf.Persist(ctx, s, 5*time.Millisecond, func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	})
+
+	select {
+	case err := <-errs:
+		require.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("the periodic save error is not reported")
+	}
+
+	cancel()
+	require.Error(t, wait())
+}
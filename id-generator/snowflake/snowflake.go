@@ -134,6 +134,19 @@ func (s *Snowflake) NextId() (int64, error) {
 	return s.calculateId()
 }
 
+// LastGenerateTime returns the generation time of the last id,
+// it returns the zero time if no id has been generated or restored.
+func (s *Snowflake) LastGenerateTime() time.Time {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.elapsedTime == 0 {
+		return time.Time{}
+	}
+
+	return unixMilliTime(s.startTime + s.elapsedTime)
+}
+
 // waitNextElapsedTime waits and returns the elapsed timestamp after growth.
 func (s *Snowflake) waitNextElapsedTime(current int64) int64 {
 	for current <= s.elapsedTime {
@@ -162,6 +175,11 @@ func unixMilli(t time.Time) int64 {
 	return t.UnixNano() / int64(time.Millisecond)
 }
 
+// unixMilliTime gets the local time of the millisecond timestamp.
+func unixMilliTime(millis int64) time.Time {
+	return time.Unix(0, millis*int64(time.Millisecond))
+}
+
 // NodeId returns the node id constructor.
 func NodeId(nodeId int64) func() (int64, error) {
 	return func() (int64, error) { return nodeId, nil }
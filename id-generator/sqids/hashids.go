@@ -0,0 +1,279 @@
+package sqids
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+const (
+	// DefaultHashidsAlphabet hashids default alphabet.
+	DefaultHashidsAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890"
+
+	minHashidsAlphabetLength = 16               // min length of hashids alphabet
+	hashidsSeps              = "cfhistuCFHISTU" // original separators
+	sepDiv                   = 3.5              // ratio of alphabet length to separators length
+	guardDiv                 = 12.0             // ratio of alphabet length to guards length
+)
+
+var (
+	// ErrHashidsAlphabet invalid hashids alphabet error.
+	ErrHashidsAlphabet = errors.New("hashids: alphabet must contain at least 16 unique single-byte characters without spaces")
+	// ErrHashidsNegative negative number error.
+	ErrHashidsNegative = errors.New("hashids: negative number not supported")
+	// ErrHashidsEmpty encoding empty numbers error.
+	ErrHashidsEmpty = errors.New("hashids: encoding empty numbers makes no sense")
+	// ErrHashidsInvalid invalid hash error.
+	ErrHashidsInvalid = errors.New("hashids: invalid hash")
+)
+
+// HashidsConfig hashids config.
+type HashidsConfig struct {
+	Alphabet  string // alphabet, default is DefaultHashidsAlphabet
+	MinLength int    // min length of generated hashes
+	Salt      string // salt, hashes generated with different salts are different
+}
+
+// Hashids legacy reversible id obfuscator compatible with hashids (https://hashids.org),
+// new projects should prefer Sqids.
+type Hashids struct {
+	alphabet  []byte
+	minLength int
+	salt      []byte
+	seps      []byte
+	guards    []byte
+}
+
+// MustNewHashids must new a hashids obfuscator.
+func MustNewHashids(c *HashidsConfig) *Hashids {
+	h, err := NewHashids(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return h
+}
+
+// NewHashids new a hashids obfuscator, nil config means the default config.
+func NewHashids(c *HashidsConfig) (*Hashids, error) {
+	if c == nil {
+		c = &HashidsConfig{}
+	}
+
+	alphabet := c.Alphabet
+	if alphabet == "" {
+		alphabet = DefaultHashidsAlphabet
+	}
+	if strings.Contains(alphabet, " ") || checkAlphabet(alphabet, minHashidsAlphabetLength) != nil {
+		return nil, ErrHashidsAlphabet
+	}
+
+	salt := []byte(c.Salt)
+
+	// separators are the original separators in the alphabet, and are removed from the alphabet
+	seps := make([]byte, 0, len(hashidsSeps))
+	alpha := make([]byte, 0, len(alphabet))
+	for i := 0; i < len(hashidsSeps); i++ {
+		if strings.IndexByte(alphabet, hashidsSeps[i]) >= 0 {
+			seps = append(seps, hashidsSeps[i])
+		}
+	}
+	for i := 0; i < len(alphabet); i++ {
+		if strings.IndexByte(hashidsSeps, alphabet[i]) < 0 {
+			alpha = append(alpha, alphabet[i])
+		}
+	}
+	consistentShuffle(seps, salt)
+
+	if len(seps) == 0 || float64(len(alpha))/float64(len(seps)) > sepDiv {
+		sepsLength := int(math.Ceil(float64(len(alpha)) / sepDiv))
+		if sepsLength == 1 {
+			sepsLength++
+		}
+		if sepsLength > len(seps) {
+			diff := sepsLength - len(seps)
+			seps = append(seps, alpha[:diff]...)
+			alpha = alpha[diff:]
+		} else {
+			seps = seps[:sepsLength]
+		}
+	}
+	consistentShuffle(alpha, salt)
+
+	var guards []byte
+	guardCount := int(math.Ceil(float64(len(alpha)) / guardDiv))
+	if len(alpha) < 3 {
+		guards = seps[:guardCount]
+		seps = seps[guardCount:]
+	} else {
+		guards = alpha[:guardCount]
+		alpha = alpha[guardCount:]
+	}
+
+	return &Hashids{
+		alphabet:  alpha,
+		minLength: c.MinLength,
+		salt:      salt,
+		seps:      seps,
+		guards:    guards,
+	}, nil
+}
+
+// Encode encodes non-negative numbers into a hash.
+func (h *Hashids) Encode(numbers ...int64) (string, error) {
+	if len(numbers) == 0 {
+		return "", ErrHashidsEmpty
+	}
+
+	var numbersHash int64
+	for i, n := range numbers {
+		if n < 0 {
+			return "", ErrHashidsNegative
+		}
+		numbersHash += n % int64(i+100)
+	}
+
+	alphabet := make([]byte, len(h.alphabet))
+	copy(alphabet, h.alphabet)
+
+	lottery := alphabet[numbersHash%int64(len(alphabet))]
+	result := make([]byte, 0, h.minLength+16)
+	result = append(result, lottery)
+	buffer := make([]byte, 0, len(alphabet)+len(h.salt)+1)
+
+	for i, n := range numbers {
+		buffer = append(buffer[:0], lottery)
+		buffer = append(buffer, h.salt...)
+		buffer = append(buffer, alphabet...)
+		consistentShuffle(alphabet, buffer[:len(alphabet)])
+
+		start := len(result)
+		result = appendHash(result, n, alphabet)
+
+		if i+1 < len(numbers) {
+			n %= int64(result[start]) + int64(i)
+			result = append(result, h.seps[n%int64(len(h.seps))])
+		}
+	}
+
+	if len(result) < h.minLength {
+		guardIndex := (numbersHash + int64(result[0])) % int64(len(h.guards))
+		result = append([]byte{h.guards[guardIndex]}, result...)
+
+		if len(result) < h.minLength {
+			guardIndex = (numbersHash + int64(result[2])) % int64(len(h.guards))
+			result = append(result, h.guards[guardIndex])
+		}
+	}
+
+	halfLength := len(alphabet) / 2
+	for len(result) < h.minLength {
+		salt := make([]byte, len(alphabet))
+		copy(salt, alphabet)
+		consistentShuffle(alphabet, salt)
+
+		r := make([]byte, 0, len(alphabet)+len(result))
+		r = append(r, alphabet[halfLength:]...)
+		r = append(r, result...)
+		r = append(r, alphabet[:halfLength]...)
+		result = r
+
+		if excess := len(result) - h.minLength; excess > 0 {
+			result = result[excess/2 : excess/2+h.minLength]
+		}
+	}
+
+	return string(result), nil
+}
+
+// Decode decodes a hash into numbers, the hash is re-encoded to
+// verify that it is exactly the one generated by Encode.
+func (h *Hashids) Decode(hash string) ([]int64, error) {
+	if hash == "" {
+		return nil, ErrHashidsInvalid
+	}
+
+	parts := splitBytes([]byte(hash), h.guards)
+	breakdown := parts[0]
+	if len(parts) == 2 || len(parts) == 3 {
+		breakdown = parts[1]
+	}
+	if len(breakdown) == 0 {
+		return nil, ErrHashidsInvalid
+	}
+
+	lottery := breakdown[0]
+	alphabet := make([]byte, len(h.alphabet))
+	copy(alphabet, h.alphabet)
+	buffer := make([]byte, 0, len(alphabet)+len(h.salt)+1)
+
+	result := make([]int64, 0, 1)
+	for _, sub := range splitBytes(breakdown[1:], h.seps) {
+		buffer = append(buffer[:0], lottery)
+		buffer = append(buffer, h.salt...)
+		buffer = append(buffer, alphabet...)
+		consistentShuffle(alphabet, buffer[:len(alphabet)])
+
+		var n int64
+		for _, c := range sub {
+			p := indexByte(alphabet, c)
+			if p < 0 {
+				return nil, ErrHashidsInvalid
+			}
+			n = n*int64(len(alphabet)) + int64(p)
+		}
+		result = append(result, n)
+	}
+
+	if expect, err := h.Encode(result...); err != nil || expect != hash {
+		return nil, ErrHashidsInvalid
+	}
+
+	return result, nil
+}
+
+// consistentShuffle shuffles the alphabet in place by the salt.
+func consistentShuffle(alphabet, salt []byte) {
+	if len(salt) == 0 {
+		return
+	}
+
+	for i, v, p := len(alphabet)-1, 0, 0; i > 0; i-- {
+		p += int(salt[v])
+		j := (int(salt[v]) + v + p) % i
+		alphabet[i], alphabet[j] = alphabet[j], alphabet[i]
+		v = (v + 1) % len(salt)
+	}
+}
+
+// appendHash appends the number hashed by the alphabet to dst.
+func appendHash(dst []byte, n int64, alphabet []byte) []byte {
+	l := int64(len(alphabet))
+	start := len(dst)
+
+	for {
+		dst = append(dst, alphabet[n%l])
+		n /= l
+		if n == 0 {
+			break
+		}
+	}
+
+	reverse(dst[start:])
+
+	return dst
+}
+
+// splitBytes splits b by any of the separators.
+func splitBytes(b, seps []byte) [][]byte {
+	parts := make([][]byte, 0, 4)
+	start := 0
+	for i, c := range b {
+		if indexByte(seps, c) >= 0 {
+			parts = append(parts, b[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, b[start:])
+}
@@ -0,0 +1,91 @@
+package sqids
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHashids(t *testing.T) {
+	for _, alphabet := range []string{"abc", "abcdefghijklmnop ", "abcdefghijklmnopa", "abcdefghijklmnopë"} {
+		_, err := NewHashids(&HashidsConfig{Alphabet: alphabet})
+		require.ErrorIs(t, err, ErrHashidsAlphabet)
+	}
+
+	h, err := NewHashids(nil)
+	require.NoError(t, err)
+	assert.NotNil(t, h)
+
+	assert.Panics(t, func() { MustNewHashids(&HashidsConfig{Alphabet: "abc"}) })
+}
+
+func TestHashids_Encode(t *testing.T) {
+	cases := []struct {
+		c       *HashidsConfig
+		numbers []int64
+		hash    string
+	}{
+		{c: &HashidsConfig{Salt: "this is my salt"}, numbers: []int64{12345}, hash: "NkK9"},
+		{c: &HashidsConfig{Salt: "this is my salt"}, numbers: []int64{45, 434, 1313, 99}, hash: "7nnhzEsDkiYa"},
+		{c: &HashidsConfig{Salt: "this is my salt", MinLength: 8}, numbers: []int64{1}, hash: "gB0NV05e"},
+	}
+
+	for _, c := range cases {
+		h := MustNewHashids(c.c)
+
+		hash, err := h.Encode(c.numbers...)
+		require.NoError(t, err)
+		assert.Equal(t, c.hash, hash)
+
+		numbers, err := h.Decode(hash)
+		require.NoError(t, err)
+		assert.Equal(t, c.numbers, numbers)
+	}
+
+	h := MustNewHashids(nil)
+	_, err := h.Encode()
+	require.ErrorIs(t, err, ErrHashidsEmpty)
+	_, err = h.Encode(1, -1)
+	require.ErrorIs(t, err, ErrHashidsNegative)
+}
+
+func TestHashids_RoundTrip(t *testing.T) {
+	configs := []*HashidsConfig{
+		nil,
+		{Salt: "salt", MinLength: 30},
+		{Alphabet: "cCsSfFhHuUiItT01"},
+		{Alphabet: "abdegjklmnopqrSF", Salt: "salt"},
+		{Alphabet: "`~!@#$%^&*()-_=+\\|'\";:/?.>,<{[}]", MinLength: 10},
+	}
+	cases := [][]int64{
+		{0},
+		{math.MaxInt64},
+		{1, 2, 3, 100, 1000, 100000, 1000000},
+	}
+
+	for _, c := range configs {
+		h := MustNewHashids(c)
+		for _, numbers := range cases {
+			hash, err := h.Encode(numbers...)
+			require.NoError(t, err)
+
+			decoded, err := h.Decode(hash)
+			require.NoError(t, err)
+			assert.Equal(t, numbers, decoded)
+		}
+	}
+}
+
+func TestHashids_Decode(t *testing.T) {
+	h := MustNewHashids(&HashidsConfig{Salt: "this is my salt"})
+
+	for _, hash := range []string{"", "NkK", "NkK9NkK9", "*"} {
+		_, err := h.Decode(hash)
+		require.ErrorIs(t, err, ErrHashidsInvalid)
+	}
+
+	_, err := MustNewHashids(&HashidsConfig{Salt: "other salt"}).Decode("7nnhzEsDkiYa")
+	require.ErrorIs(t, err, ErrHashidsInvalid)
+}
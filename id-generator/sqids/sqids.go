@@ -0,0 +1,368 @@
+package sqids
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/sliveryou/go-tool/v2/id-generator/encoding/basen"
+)
+
+const (
+	// DefaultAlphabet sqids default alphabet, it contains the same characters as base62 standard source.
+	DefaultAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	// MaxMinLength max value of min length.
+	MaxMinLength = 255
+
+	minAlphabetLength = 3 // min length of sqids alphabet
+)
+
+var (
+	// ErrAlphabetMultibyte alphabet contains multibyte characters error.
+	ErrAlphabetMultibyte = errors.New("sqids: alphabet must not contain any multibyte characters")
+	// ErrAlphabetTooShort alphabet too short error.
+	ErrAlphabetTooShort = errors.New("sqids: alphabet length must be at least 3")
+	// ErrAlphabetNotUnique alphabet contains duplicate characters error.
+	ErrAlphabetNotUnique = errors.New("sqids: alphabet must contain unique characters")
+	// ErrInvalidMinLength invalid min length error.
+	ErrInvalidMinLength = errors.New("sqids: min length must be between 0 and 255")
+	// ErrMaxAttempts reached max attempts to re-generate the id error.
+	ErrMaxAttempts = errors.New("sqids: reached max attempts to re-generate the id")
+	// ErrNonCanonical non-canonical id error.
+	ErrNonCanonical = errors.New("sqids: id is not canonical")
+)
+
+// Config sqids config.
+type Config struct {
+	Alphabet  string   // alphabet, default is DefaultAlphabet
+	MinLength int      // min length of generated ids, 0 to 255
+	Blocklist []string // blocklist of words which must not appear in ids, nil means the default blocklist
+}
+
+// Sqids reversible id obfuscator compatible with the sqids specification (https://sqids.org),
+// it encodes one or more non-negative numbers into a short url-safe string.
+type Sqids struct {
+	alphabet  []byte   // shuffled alphabet
+	minLength int      // min length of generated ids
+	blocklist []string // filtered lowercase blocklist
+}
+
+// DefaultBlocklist returns a copy of the default blocklist.
+func DefaultBlocklist() []string {
+	bl := make([]string, len(defaultBlocklist))
+	copy(bl, defaultBlocklist)
+
+	return bl
+}
+
+// MustNewSqids must new a sqids obfuscator.
+func MustNewSqids(c *Config) *Sqids {
+	s, err := NewSqids(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// NewSqids new a sqids obfuscator, nil config means the default config.
+func NewSqids(c *Config) (*Sqids, error) {
+	if c == nil {
+		c = &Config{}
+	}
+
+	alphabet := c.Alphabet
+	if alphabet == "" {
+		alphabet = DefaultAlphabet
+	}
+	if err := checkAlphabet(alphabet, minAlphabetLength); err != nil {
+		switch {
+		case errors.Is(err, errAlphabetMultibyte):
+			return nil, ErrAlphabetMultibyte
+		case errors.Is(err, errAlphabetTooShort):
+			return nil, ErrAlphabetTooShort
+		default:
+			return nil, ErrAlphabetNotUnique
+		}
+	}
+
+	if c.MinLength < 0 || c.MinLength > MaxMinLength {
+		return nil, ErrInvalidMinLength
+	}
+
+	blocklist := c.Blocklist
+	if blocklist == nil {
+		blocklist = defaultBlocklist
+	}
+
+	a := []byte(alphabet)
+	shuffle(a)
+
+	return &Sqids{
+		alphabet:  a,
+		minLength: c.MinLength,
+		blocklist: filterBlocklist(alphabet, blocklist),
+	}, nil
+}
+
+// alphabet check errors.
+var (
+	errAlphabetMultibyte = errors.New("alphabet multibyte")
+	errAlphabetTooShort  = errors.New("alphabet too short")
+)
+
+// checkAlphabet checks the alphabet is made of at least min length unique single-byte characters,
+// the duplicate characters are checked by the base-N encoder.
+func checkAlphabet(alphabet string, minLength int) error {
+	if utf8.RuneCountInString(alphabet) != len(alphabet) {
+		return errAlphabetMultibyte
+	}
+	if len(alphabet) < minLength {
+		return errAlphabetTooShort
+	}
+	if _, err := basen.NewEncoder(alphabet); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// filterBlocklist lowercases the blocklist words, and removes the words
+// shorter than 3 characters or containing characters not in the alphabet.
+func filterBlocklist(alphabet string, blocklist []string) []string {
+	lower := strings.ToLower(alphabet)
+	filtered := make([]string, 0, len(blocklist))
+
+	for _, word := range blocklist {
+		if len(word) < 3 {
+			continue
+		}
+
+		word = strings.ToLower(word)
+		in := true
+		for i := 0; i < len(word); i++ {
+			if strings.IndexByte(lower, word[i]) < 0 {
+				in = false
+				break
+			}
+		}
+		if in {
+			filtered = append(filtered, word)
+		}
+	}
+
+	return filtered
+}
+
+// Encode encodes numbers into an id, it returns an empty string if no numbers passed.
+func (s *Sqids) Encode(numbers ...uint64) (string, error) {
+	if len(numbers) == 0 {
+		return "", nil
+	}
+
+	return s.encode(numbers, 0)
+}
+
+// EncodeInt64 encodes non-negative int64 numbers into an id.
+func (s *Sqids) EncodeInt64(numbers ...int64) (string, error) {
+	ns := make([]uint64, 0, len(numbers))
+	for _, n := range numbers {
+		if n < 0 {
+			return "", errors.New("sqids: negative number not supported")
+		}
+		ns = append(ns, uint64(n))
+	}
+
+	return s.Encode(ns...)
+}
+
+// encode encodes numbers into an id, increment is the number of attempts
+// to re-generate the id if it is blocked.
+func (s *Sqids) encode(numbers []uint64, increment int) (string, error) {
+	l := len(s.alphabet)
+	if increment > l {
+		return "", ErrMaxAttempts
+	}
+
+	offset := len(numbers)
+	for i, n := range numbers {
+		offset += int(s.alphabet[n%uint64(l)]) + i
+	}
+	offset = (offset%l + increment) % l
+
+	alphabet := make([]byte, 0, l)
+	alphabet = append(alphabet, s.alphabet[offset:]...)
+	alphabet = append(alphabet, s.alphabet[:offset]...)
+	prefix := alphabet[0]
+	reverse(alphabet)
+
+	id := make([]byte, 0, 16)
+	id = append(id, prefix)
+	for i, n := range numbers {
+		id = appendId(id, n, alphabet[1:])
+
+		if i < len(numbers)-1 {
+			id = append(id, alphabet[0])
+			shuffle(alphabet)
+		}
+	}
+
+	if s.minLength > len(id) {
+		id = append(id, alphabet[0])
+
+		for s.minLength > len(id) {
+			shuffle(alphabet)
+			n := s.minLength - len(id)
+			if n > l {
+				n = l
+			}
+			id = append(id, alphabet[:n]...)
+		}
+	}
+
+	if s.isBlocked(string(id)) {
+		return s.encode(numbers, increment+1)
+	}
+
+	return string(id), nil
+}
+
+// Decode decodes an id into numbers, it returns an empty slice if the id is invalid.
+// Different ids may decode into the same numbers, use DecodeCanonical if it matters.
+func (s *Sqids) Decode(id string) []uint64 {
+	ret := make([]uint64, 0, 1)
+	if id == "" {
+		return ret
+	}
+
+	for i := 0; i < len(id); i++ {
+		if indexByte(s.alphabet, id[i]) < 0 {
+			return ret
+		}
+	}
+
+	l := len(s.alphabet)
+	offset := indexByte(s.alphabet, id[0])
+	alphabet := make([]byte, 0, l)
+	alphabet = append(alphabet, s.alphabet[offset:]...)
+	alphabet = append(alphabet, s.alphabet[:offset]...)
+	reverse(alphabet)
+
+	id = id[1:]
+	for id != "" {
+		chunk, rest, found := strings.Cut(id, string(alphabet[0]))
+		if chunk == "" {
+			return ret
+		}
+
+		ret = append(ret, toNumber(chunk, alphabet[1:]))
+		if found {
+			shuffle(alphabet)
+		}
+		id = rest
+	}
+
+	return ret
+}
+
+// DecodeCanonical decodes an id into numbers, and checks that the id
+// is exactly the one generated by Encode for the numbers.
+func (s *Sqids) DecodeCanonical(id string) ([]uint64, error) {
+	numbers := s.Decode(id)
+	if len(numbers) == 0 {
+		return nil, ErrNonCanonical
+	}
+
+	expect, err := s.Encode(numbers...)
+	if err != nil {
+		return nil, err
+	}
+	if expect != id {
+		return nil, ErrNonCanonical
+	}
+
+	return numbers, nil
+}
+
+// isBlocked reports whether the id contains blocked words.
+func (s *Sqids) isBlocked(id string) bool {
+	id = strings.ToLower(id)
+
+	for _, word := range s.blocklist {
+		if len(word) > len(id) {
+			continue
+		}
+
+		switch {
+		case len(id) <= 3 || len(word) <= 3:
+			if id == word {
+				return true
+			}
+		case strings.ContainsAny(word, "0123456789"):
+			if strings.HasPrefix(id, word) || strings.HasSuffix(id, word) {
+				return true
+			}
+		case strings.Contains(id, word):
+			return true
+		}
+	}
+
+	return false
+}
+
+// shuffle shuffles the alphabet in place consistently.
+func shuffle(a []byte) {
+	l := len(a)
+	for i, j := 0, l-1; j > 0; i, j = i+1, j-1 {
+		r := (i*j + int(a[i]) + int(a[j])) % l
+		a[i], a[r] = a[r], a[i]
+	}
+}
+
+// reverse reverses the alphabet in place.
+func reverse(a []byte) {
+	for i, j := 0, len(a)-1; i < j; i, j = i+1, j-1 {
+		a[i], a[j] = a[j], a[i]
+	}
+}
+
+// appendId appends the number encoded by the alphabet to dst.
+func appendId(dst []byte, n uint64, alphabet []byte) []byte {
+	l := uint64(len(alphabet))
+	start := len(dst)
+
+	for {
+		dst = append(dst, alphabet[n%l])
+		n /= l
+		if n == 0 {
+			break
+		}
+	}
+
+	reverse(dst[start:])
+
+	return dst
+}
+
+// toNumber decodes the id chunk to number by the alphabet.
+func toNumber(chunk string, alphabet []byte) uint64 {
+	l := uint64(len(alphabet))
+
+	var n uint64
+	for i := 0; i < len(chunk); i++ {
+		n = n*l + uint64(indexByte(alphabet, chunk[i]))
+	}
+
+	return n
+}
+
+// indexByte returns the index of the first instance of c in b, or -1 if c is not present in b.
+func indexByte(b []byte, c byte) int {
+	for i := range b {
+		if b[i] == c {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,143 @@
+package sqids
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSqids(t *testing.T) {
+	cases := []struct {
+		c   *Config
+		err error
+	}{
+		{c: &Config{Alphabet: "ë1092"}, err: ErrAlphabetMultibyte},
+		{c: &Config{Alphabet: "ab"}, err: ErrAlphabetTooShort},
+		{c: &Config{Alphabet: "aab"}, err: ErrAlphabetNotUnique},
+		{c: &Config{MinLength: -1}, err: ErrInvalidMinLength},
+		{c: &Config{MinLength: 256}, err: ErrInvalidMinLength},
+	}
+
+	for _, c := range cases {
+		_, err := NewSqids(c.c)
+		require.ErrorIs(t, err, c.err)
+	}
+
+	s, err := NewSqids(nil)
+	require.NoError(t, err)
+	assert.NotNil(t, s)
+
+	assert.Panics(t, func() { MustNewSqids(&Config{Alphabet: "ab"}) })
+	assert.Len(t, DefaultBlocklist(), 560)
+}
+
+func TestSqids_Encode(t *testing.T) {
+	cases := []struct {
+		c       *Config
+		numbers []uint64
+		id      string
+	}{
+		{c: nil, numbers: []uint64{1, 2, 3}, id: "86Rf07"},
+		{c: &Config{MinLength: 10}, numbers: []uint64{1, 2, 3}, id: "86Rf07xd4z"},
+		{c: &Config{MinLength: 62}, numbers: []uint64{1, 2, 3}, id: "86Rf07xd4zBmiJXQG6otHEbew02c3PWsUOLZxADhCpKj7aVFv9I8RquYrNlSTM"},
+		{c: &Config{MinLength: 65}, numbers: []uint64{1, 2, 3}, id: "86Rf07xd4zBmiJXQG6otHEbew02c3PWsUOLZxADhCpKj7aVFv9I8RquYrNlSTMyf1"},
+		{c: &Config{Alphabet: "0123456789abcdef"}, numbers: []uint64{1, 2, 3}, id: "489158"},
+		{c: nil, numbers: []uint64{4572721}, id: "JExTR"},
+		{c: &Config{Blocklist: []string{}}, numbers: []uint64{4572721}, id: "aho1e"},
+	}
+
+	for _, c := range cases {
+		s := MustNewSqids(c.c)
+
+		id, err := s.Encode(c.numbers...)
+		require.NoError(t, err)
+		assert.Equal(t, c.id, id)
+		assert.Equal(t, c.numbers, s.Decode(id))
+	}
+
+	s := MustNewSqids(nil)
+	id, err := s.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, "", id)
+
+	// blocked ids still decode, but are never generated
+	assert.Equal(t, []uint64{4572721}, s.Decode("aho1e"))
+}
+
+func TestSqids_RoundTrip(t *testing.T) {
+	cases := [][]uint64{
+		{0},
+		{0, 0, 0, 1, 2, 3, 100, 1000, 100000, 1000000, math.MaxUint64},
+		{math.MaxUint64},
+	}
+	for i := uint64(0); i < 1000; i += 7 {
+		cases = append(cases, []uint64{i, i * 31})
+	}
+
+	for _, c := range []*Config{nil, {Alphabet: "abc", Blocklist: []string{}}, {MinLength: 20}} {
+		s := MustNewSqids(c)
+		for _, numbers := range cases {
+			id, err := s.Encode(numbers...)
+			require.NoError(t, err)
+			assert.Equal(t, numbers, s.Decode(id))
+
+			decoded, err := s.DecodeCanonical(id)
+			require.NoError(t, err)
+			assert.Equal(t, numbers, decoded)
+		}
+	}
+}
+
+func TestSqids_EncodeInt64(t *testing.T) {
+	s := MustNewSqids(nil)
+
+	id, err := s.EncodeInt64(1, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "86Rf07", id)
+
+	_, err = s.EncodeInt64(-1)
+	require.EqualError(t, err, "sqids: negative number not supported")
+}
+
+func TestSqids_Decode(t *testing.T) {
+	s := MustNewSqids(nil)
+
+	assert.Empty(t, s.Decode(""))
+	assert.Empty(t, s.Decode("*"))
+	assert.Empty(t, s.Decode("86Rf07*"))
+
+	_, err := s.DecodeCanonical("")
+	require.ErrorIs(t, err, ErrNonCanonical)
+
+	// a different id that decodes to the same numbers
+	id, err := s.Encode(1)
+	require.NoError(t, err)
+	numbers := s.Decode(id + string(id[len(id)-1]))
+	if len(numbers) != 0 {
+		_, err = s.DecodeCanonical(id + string(id[len(id)-1]))
+		require.ErrorIs(t, err, ErrNonCanonical)
+	}
+}
+
+func TestSqids_Blocklist(t *testing.T) {
+	s := MustNewSqids(&Config{Blocklist: []string{"JSwXFaosAN", "OCjV9JK64o", "rBHf", "79SM", "7tE6"}})
+
+	id, err := s.Encode(1000000, 2000000)
+	require.NoError(t, err)
+	assert.Equal(t, "1aYeB7bRUt", id)
+	assert.Equal(t, []uint64{1000000, 2000000}, s.Decode(id))
+
+	// words shorter than 3 characters or with characters not in the alphabet are ignored
+	s = MustNewSqids(&Config{Alphabet: "abc", Blocklist: []string{"ab", "abd", "CAB"}})
+	assert.Equal(t, []string{"cab"}, s.blocklist)
+}
+
+func BenchmarkSqids_Encode(b *testing.B) {
+	s := MustNewSqids(nil)
+
+	for i := 0; i < b.N; i++ {
+		_, _ = s.Encode(1, 2, 3, 4, 5)
+	}
+}
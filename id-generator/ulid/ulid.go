@@ -0,0 +1,345 @@
+package ulid
+
+import (
+	"bytes"
+	"crypto/rand"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// +------------------------------------------------+------------------------------------------------------------------------------+
+// | timestamp (48 bits)                            | entropy (80 bits)                                                            |
+// +------------------------------------------------+------------------------------------------------------------------------------+
+// | 01AN4Z07BY (10 characters)                     | 79KA1307SR9X4MV3 (16 characters)                                             |
+// +------------------------------------------------+------------------------------------------------------------------------------+
+
+// ulid (universally unique lexicographically sortable identifier), 128 bits
+// 48 bits timestamp (millisecond), unix millisecond timestamp, which can be used until the year 10889
+// 80 bits entropy, random bytes
+// the text form is 26 characters of crockford base32, which sorts in the same order as the binary form
+
+const (
+	// EncodedSize ulid text encoded size.
+	EncodedSize = 26
+	// BinarySize ulid binary size.
+	BinarySize = 16
+
+	maxTime uint64 = 1<<48 - 1 // max value of timestamp, default is 281474976710655
+)
+
+var (
+	// ErrBigTime big time error.
+	ErrBigTime = errors.New("ulid: time is too big")
+	// ErrDataSize data size error.
+	ErrDataSize = errors.New("ulid: bad data size when unmarshalling")
+	// ErrInvalidCharacters invalid characters error.
+	ErrInvalidCharacters = errors.New("ulid: bad data characters when unmarshalling")
+	// ErrOverflow overflow error, the first character of text form is larger than 7.
+	ErrOverflow = errors.New("ulid: overflow when unmarshalling")
+	// ErrMonotonicOverflow monotonic overflow error,
+	// the entropy in the same millisecond is exhausted.
+	ErrMonotonicOverflow = errors.New("ulid: monotonic entropy overflow")
+	// ErrScanValue scan value error.
+	ErrScanValue = errors.New("ulid: source value must be a string or byte slice")
+
+	// Zero zero ulid.
+	Zero ULID
+
+	// defaultGenerator default monotonic generator.
+	defaultGenerator = NewGenerator(true)
+)
+
+// crockford base32 encoding source string.
+const encodeSource = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// decodeMap crockford base32 decoding map, which is case-insensitive
+// and accepts I, L as 1 and O as 0.
+var decodeMap = func() [256]byte {
+	var m [256]byte
+	for i := range m {
+		m[i] = 0xFF
+	}
+	for i := 0; i < len(encodeSource); i++ {
+		m[encodeSource[i]] = byte(i)
+		m[encodeSource[i]|0x20] = byte(i) // lowercase
+	}
+	m['I'], m['i'], m['L'], m['l'] = 1, 1, 1, 1
+	m['O'], m['o'] = 0, 0
+
+	return m
+}()
+
+// ULID universally unique lexicographically sortable identifier.
+type ULID [BinarySize]byte
+
+// Generator ulid generator.
+// In monotonic mode, ulids generated in the same millisecond increase
+// the entropy of the last ulid by one instead of reading new random bytes,
+// so they are strictly ordered.
+type Generator struct {
+	mutex     *sync.Mutex // mutex, used to ensure concurrency security
+	monotonic bool        // whether to use monotonic entropy
+	entropy   io.Reader   // entropy source
+	last      ULID        // last generated ulid
+}
+
+// NewGenerator new a ulid generator with crypto/rand entropy.
+func NewGenerator(monotonic bool) *Generator {
+	return NewGeneratorWithEntropy(monotonic, rand.Reader)
+}
+
+// NewGeneratorWithEntropy new a ulid generator with the entropy source.
+func NewGeneratorWithEntropy(monotonic bool, entropy io.Reader) *Generator {
+	return &Generator{mutex: new(sync.Mutex), monotonic: monotonic, entropy: entropy}
+}
+
+// New generates ulid at the current time.
+func (g *Generator) New() (ULID, error) {
+	return g.NewAt(time.Now())
+}
+
+// NewAt generates ulid at the specified time.
+// In monotonic mode, if the time is not after the last generate time,
+// the last generate time is used, so that ulids never go backwards.
+func (g *Generator) NewAt(t time.Time) (ULID, error) {
+	ms := Timestamp(t)
+	if ms > maxTime {
+		return Zero, ErrBigTime
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	var u ULID
+	if g.monotonic && !g.last.IsZero() && ms <= g.last.Time() {
+		u = g.last
+		if !u.incrEntropy() {
+			return Zero, ErrMonotonicOverflow
+		}
+	} else {
+		_ = u.SetTime(ms)
+		if _, err := io.ReadFull(g.entropy, u[6:]); err != nil {
+			return Zero, err
+		}
+	}
+	g.last = u
+
+	return u, nil
+}
+
+// incrEntropy increases the entropy by one,
+// it reports false if the entropy overflows.
+func (u *ULID) incrEntropy() bool {
+	for i := BinarySize - 1; i >= 6; i-- {
+		u[i]++
+		if u[i] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// New generates ulid with the default monotonic generator.
+func New() (ULID, error) {
+	return defaultGenerator.New()
+}
+
+// Next generates ulid string with the default monotonic generator,
+// it panics if an error occurred.
+func Next() string {
+	u, err := New()
+	if err != nil {
+		panic(err)
+	}
+
+	return u.String()
+}
+
+// Parse parses ulid string, it is case-insensitive.
+func Parse(s string) (ULID, error) {
+	var u ULID
+	return u, parse([]byte(s), &u)
+}
+
+// MustParse must parse ulid string.
+func MustParse(s string) ULID {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// Timestamp converts time to unix millisecond timestamp.
+func Timestamp(t time.Time) uint64 {
+	return uint64(t.UnixMilli())
+}
+
+// Time returns the unix millisecond timestamp of ulid.
+func (u ULID) Time() uint64 {
+	return uint64(u[5]) | uint64(u[4])<<8 | uint64(u[3])<<16 |
+		uint64(u[2])<<24 | uint64(u[1])<<32 | uint64(u[0])<<40
+}
+
+// Timestamp returns the generate time of ulid.
+func (u ULID) Timestamp() time.Time {
+	return time.UnixMilli(int64(u.Time()))
+}
+
+// SetTime sets the unix millisecond timestamp of ulid.
+func (u *ULID) SetTime(ms uint64) error {
+	if ms > maxTime {
+		return ErrBigTime
+	}
+
+	u[0], u[1], u[2] = byte(ms>>40), byte(ms>>32), byte(ms>>24)
+	u[3], u[4], u[5] = byte(ms>>16), byte(ms>>8), byte(ms)
+
+	return nil
+}
+
+// Entropy returns the entropy bytes of ulid.
+func (u ULID) Entropy() []byte {
+	e := make([]byte, 10)
+	copy(e, u[6:])
+
+	return e
+}
+
+// Bytes returns the binary bytes of ulid.
+func (u ULID) Bytes() []byte {
+	b := make([]byte, BinarySize)
+	copy(b, u[:])
+
+	return b
+}
+
+// IsZero reports whether ulid is zero.
+func (u ULID) IsZero() bool {
+	return u == Zero
+}
+
+// Compare returns an integer comparing two ulids lexicographically.
+// The result will be 0 if u == other, -1 if u < other, and +1 if u > other.
+func (u ULID) Compare(other ULID) int {
+	return bytes.Compare(u[:], other[:])
+}
+
+// String returns the crockford base32 text form of ulid.
+func (u ULID) String() string {
+	b := make([]byte, EncodedSize)
+	u.encode(b)
+
+	return string(b)
+}
+
+// encode encodes ulid to the 26 bytes destination.
+func (u ULID) encode(dst []byte) {
+	// 128 bits are encoded to 26 characters from the highest bit,
+	// the first character only holds 3 bits
+	var acc uint64
+	bits, j := uint(2), 0
+	for i := 0; i < BinarySize; i++ {
+		acc = acc<<8 | uint64(u[i])
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			dst[j] = encodeSource[acc>>bits&0x1F]
+			j++
+		}
+	}
+}
+
+// parse parses the 26 bytes text form to ulid.
+func parse(src []byte, u *ULID) error {
+	if len(src) != EncodedSize {
+		return ErrDataSize
+	}
+
+	for _, c := range src {
+		if decodeMap[c] == 0xFF {
+			return ErrInvalidCharacters
+		}
+	}
+
+	if decodeMap[src[0]] > 7 {
+		return ErrOverflow
+	}
+
+	var acc uint64
+	bits, j := uint(0), 0
+	for i, c := range src {
+		acc = acc<<5 | uint64(decodeMap[c])
+		bits += 5
+		if i == 0 {
+			bits = 3 // drops the 2 padding bits
+		}
+		for bits >= 8 {
+			bits -= 8
+			u[j] = byte(acc >> bits)
+			j++
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (u ULID) MarshalBinary() ([]byte, error) {
+	return u.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (u *ULID) UnmarshalBinary(data []byte) error {
+	if len(data) != BinarySize {
+		return ErrDataSize
+	}
+	copy(u[:], data)
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface,
+// ulid is marshalled to JSON as a string by it.
+func (u ULID) MarshalText() ([]byte, error) {
+	b := make([]byte, EncodedSize)
+	u.encode(b)
+
+	return b, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *ULID) UnmarshalText(data []byte) error {
+	return parse(data, u)
+}
+
+// Scan implements the sql.Scanner interface,
+// it accepts the text form string and the text or binary form bytes.
+func (u *ULID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*u = Zero
+		return nil
+	case string:
+		return u.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == BinarySize {
+			return u.UnmarshalBinary(v)
+		}
+		return u.UnmarshalText(v)
+	default:
+		return fmt.Errorf("%w, got %T", ErrScanValue, src)
+	}
+}
+
+// Value implements the driver.Valuer interface,
+// ulid is stored as the text form string, which keeps the ordering.
+func (u ULID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
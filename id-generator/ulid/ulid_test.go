@@ -0,0 +1,158 @@
+package ulid
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	u, err := Parse("01ARYZ6S41TSV4RRFFQ69G5FAV")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1469918176385), u.Time())
+	assert.Equal(t, int64(1469918176385), u.Timestamp().UnixMilli())
+	assert.Equal(t, "01ARYZ6S41TSV4RRFFQ69G5FAV", u.String())
+
+	u2, err := Parse("01aryz6s41tsv4rrffq69g5fav")
+	require.NoError(t, err)
+	assert.Equal(t, u, u2)
+
+	assert.Equal(t, "00000000000000000000000000", Zero.String())
+	assert.Equal(t, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", MustParse("7ZZZZZZZZZZZZZZZZZZZZZZZZZ").String())
+
+	_, err = Parse("01ARYZ6S41")
+	require.ErrorIs(t, err, ErrDataSize)
+	_, err = Parse("01ARYZ6S41TSV4RRFFQ69G5FA#")
+	require.ErrorIs(t, err, ErrInvalidCharacters)
+	_, err = Parse("80000000000000000000000000")
+	require.ErrorIs(t, err, ErrOverflow)
+	assert.Panics(t, func() { MustParse("err ulid") })
+}
+
+func TestGenerator_NewAt(t *testing.T) {
+	now := time.Now()
+
+	g := NewGenerator(true)
+	u1, err := g.NewAt(now)
+	require.NoError(t, err)
+	u2, err := g.NewAt(now)
+	require.NoError(t, err)
+	assert.Equal(t, u1.Time(), u2.Time())
+	assert.Equal(t, -1, u1.Compare(u2))
+	assert.Equal(t, -1, bytes.Compare(u1.Entropy(), u2.Entropy()))
+
+	// clock backward keeps the ordering
+	u3, err := g.NewAt(now.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, -1, u2.Compare(u3))
+
+	_, err = g.NewAt(time.UnixMilli(int64(maxTime) + 1))
+	require.ErrorIs(t, err, ErrBigTime)
+
+	zero := NewGeneratorWithEntropy(true, bytes.NewReader(make([]byte, 10)))
+	z, err := zero.NewAt(time.UnixMilli(0))
+	require.NoError(t, err)
+	assert.True(t, z.IsZero())
+
+	full := NewGeneratorWithEntropy(true, bytes.NewReader(bytes.Repeat([]byte{0xFF}, 10)))
+	_, err = full.NewAt(now)
+	require.NoError(t, err)
+	_, err = full.NewAt(now)
+	require.ErrorIs(t, err, ErrMonotonicOverflow)
+
+	g2 := NewGenerator(false)
+	u4, err := g2.NewAt(now)
+	require.NoError(t, err)
+	u5, err := g2.NewAt(now)
+	require.NoError(t, err)
+	assert.Equal(t, u4.Time(), u5.Time())
+	assert.NotEqual(t, u4, u5)
+}
+
+func TestNext(t *testing.T) {
+	c := make(chan string)
+	wg := sync.WaitGroup{}
+	wg.Add(100)
+
+	for i := 0; i < 100; i++ {
+		go func() {
+			defer wg.Done()
+			c <- Next()
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(c)
+	}()
+
+	r := make([]string, 0, 100)
+	for s := range c {
+		r = append(r, s)
+	}
+
+	uniqueMap := make(map[string]struct{}, len(r))
+	for _, s := range r {
+		uniqueMap[s] = struct{}{}
+	}
+	assert.Len(t, uniqueMap, len(r))
+}
+
+func TestULID_Order(t *testing.T) {
+	us := make([]ULID, 0, 1000)
+	strs := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		u, err := New()
+		require.NoError(t, err)
+		us = append(us, u)
+		strs = append(strs, u.String())
+	}
+
+	assert.True(t, sort.SliceIsSorted(us, func(i, j int) bool { return us[i].Compare(us[j]) < 0 }))
+	assert.True(t, sort.StringsAreSorted(strs))
+}
+
+func TestULID_Marshal(t *testing.T) {
+	u := MustParse("01ARYZ6S41TSV4RRFFQ69G5FAV")
+
+	b, err := u.MarshalBinary()
+	require.NoError(t, err)
+	var u2 ULID
+	require.NoError(t, u2.UnmarshalBinary(b))
+	assert.Equal(t, u, u2)
+	require.ErrorIs(t, u2.UnmarshalBinary(b[1:]), ErrDataSize)
+
+	j, err := json.Marshal(map[string]ULID{"id": u})
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"01ARYZ6S41TSV4RRFFQ69G5FAV"}`, string(j))
+	var m map[string]ULID
+	require.NoError(t, json.Unmarshal(j, &m))
+	assert.Equal(t, u, m["id"])
+
+	v, err := u.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "01ARYZ6S41TSV4RRFFQ69G5FAV", v)
+
+	var s ULID
+	require.NoError(t, s.Scan("01ARYZ6S41TSV4RRFFQ69G5FAV"))
+	assert.Equal(t, u, s)
+	require.NoError(t, s.Scan(u.Bytes()))
+	assert.Equal(t, u, s)
+	require.NoError(t, s.Scan([]byte("01ARYZ6S41TSV4RRFFQ69G5FAV")))
+	assert.Equal(t, u, s)
+	require.NoError(t, s.Scan(nil))
+	assert.True(t, s.IsZero())
+	require.ErrorIs(t, s.Scan(1), ErrScanValue)
+}
+
+func BenchmarkNew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = New()
+	}
+}
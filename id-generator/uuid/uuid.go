@@ -1,12 +1,42 @@
 package uuid
 
 import (
+	"errors"
 	"log"
 	"strings"
 
 	"github.com/google/uuid"
 )
 
+// Format uuid string format flags, flags can be combined with |.
+type Format uint8
+
+// uuid string format flags.
+const (
+	// FormatCompact 32 hex digits without dashes, e.g. 936dbe97ec4e4dedb459ef676b566485.
+	FormatCompact Format = 0
+	// FormatDashed 36 characters with dashes, e.g. 936dbe97-ec4e-4ded-b459-ef676b566485.
+	FormatDashed Format = 1 << iota
+	// FormatUpper upper case hex digits, e.g. 936DBE97EC4E4DEDB459EF676B566485.
+	FormatUpper
+	// FormatBraces wrapped in braces, e.g. {936dbe97-ec4e-4ded-b459-ef676b566485}.
+	FormatBraces
+	// FormatURN RFC 2141 urn form, e.g. urn:uuid:936dbe97-ec4e-4ded-b459-ef676b566485,
+	// it always has dashes and takes precedence over FormatBraces.
+	FormatURN
+)
+
+// well known name space ids.
+var (
+	NamespaceDNS  = uuid.NameSpaceDNS
+	NamespaceURL  = uuid.NameSpaceURL
+	NamespaceOID  = uuid.NameSpaceOID
+	NamespaceX500 = uuid.NameSpaceX500
+)
+
+// ErrUnsupportedVersion unsupported version error.
+var ErrUnsupportedVersion = errors.New("uuid: unsupported version")
+
 // NextV1 generates v1 uuid.
 func NextV1() string {
 	u, err := uuid.NewUUID()
@@ -18,6 +48,11 @@ func NextV1() string {
 	return strings.ReplaceAll(u.String(), "-", "")
 }
 
+// NextV3 generates v3 uuid based on the MD5 hash of the name space id and name.
+func NextV3(space uuid.UUID, name string) string {
+	return String(NewV3(space, name))
+}
+
 // NextV4 generates v4 uuid.
 func NextV4() string {
 	u, err := uuid.NewRandom()
@@ -29,7 +64,143 @@ func NextV4() string {
 	return strings.ReplaceAll(u.String(), "-", "")
 }
 
-// Parse parses uuid.
+// NextV5 generates v5 uuid based on the SHA1 hash of the name space id and name.
+func NextV5(space uuid.UUID, name string) string {
+	return String(NewV5(space, name))
+}
+
+// NextV6 generates v6 uuid.
+func NextV6() string {
+	u, err := NewV6()
+	if err != nil {
+		log.Printf("uuid: NextV6 err: %v", err)
+		return ""
+	}
+
+	return String(u)
+}
+
+// NextV7 generates v7 uuid.
+func NextV7() string {
+	u, err := NewV7()
+	if err != nil {
+		log.Printf("uuid: NextV7 err: %v", err)
+		return ""
+	}
+
+	return String(u)
+}
+
+// NewV1 generates v1 uuid based on the current time and node id.
+func NewV1() (uuid.UUID, error) {
+	return uuid.NewUUID()
+}
+
+// NewV3 generates v3 uuid based on the MD5 hash of the name space id and name.
+func NewV3(space uuid.UUID, name string) uuid.UUID {
+	return uuid.NewMD5(space, []byte(name))
+}
+
+// NewV4 generates v4 uuid based on random numbers.
+func NewV4() (uuid.UUID, error) {
+	return uuid.NewRandom()
+}
+
+// NewV5 generates v5 uuid based on the SHA1 hash of the name space id and name.
+func NewV5(space uuid.UUID, name string) uuid.UUID {
+	return uuid.NewSHA1(space, []byte(name))
+}
+
+// NewV6 generates v6 uuid, a field-compatible version of v1 reordered for improved db locality.
+func NewV6() (uuid.UUID, error) {
+	return uuid.NewV6()
+}
+
+// NewV7 generates v7 uuid based on the unix millisecond timestamp and random numbers,
+// it is time-ordered and suitable as the database primary key.
+func NewV7() (uuid.UUID, error) {
+	return uuid.NewV7()
+}
+
+// New generates uuid by the version, only time-based and random versions 1, 4, 6 and 7 are supported.
+func New(version int) (uuid.UUID, error) {
+	switch version {
+	case 1:
+		return NewV1()
+	case 4:
+		return NewV4()
+	case 6:
+		return NewV6()
+	case 7:
+		return NewV7()
+	default:
+		return uuid.Nil, ErrUnsupportedVersion
+	}
+}
+
+// NewBatch generates n uuids by the version, versions 1, 4, 6 and 7 are supported.
+func NewBatch(version, n int) ([]uuid.UUID, error) {
+	if n < 0 {
+		n = 0
+	}
+
+	us := make([]uuid.UUID, 0, n)
+	for i := 0; i < n; i++ {
+		u, err := New(version)
+		if err != nil {
+			return nil, err
+		}
+		us = append(us, u)
+	}
+
+	return us, nil
+}
+
+// NextBatch generates n uuid strings by the version and format, default format is FormatCompact.
+func NextBatch(version, n int, format ...Format) ([]string, error) {
+	us, err := NewBatch(version, n)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := make([]string, 0, len(us))
+	for _, u := range us {
+		ss = append(ss, String(u, format...))
+	}
+
+	return ss, nil
+}
+
+// String returns the uuid string by the format, default format is FormatCompact.
+func String(u uuid.UUID, format ...Format) string {
+	f := FormatCompact
+	if len(format) != 0 {
+		f = format[0]
+	}
+
+	s := u.String()
+	if f&(FormatDashed|FormatURN) == 0 {
+		s = strings.ReplaceAll(s, "-", "")
+	}
+	if f&FormatUpper != 0 {
+		s = strings.ToUpper(s)
+	}
+
+	switch {
+	case f&FormatURN != 0:
+		s = "urn:uuid:" + s
+	case f&FormatBraces != 0:
+		s = "{" + s + "}"
+	}
+
+	return s
+}
+
+// Parse parses uuid, all the forms produced by String are accepted.
 func Parse(input string) (uuid.UUID, error) {
+	if len(input) == 34 && input[0] == '{' && input[33] == '}' {
+		input = input[1:33]
+	}
+
 	return uuid.Parse(input)
 }
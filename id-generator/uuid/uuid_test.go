@@ -1,6 +1,7 @@
 package uuid
 
 import (
+	"strings"
 	"sync"
 	"testing"
 
@@ -54,6 +55,89 @@ func TestNextV4(t *testing.T) {
 	wg.Wait()
 }
 
+func TestNextV3V5(t *testing.T) {
+	assert.Equal(t, "6fa459eaee8a3ca4894edb77e160355e", NextV3(NamespaceDNS, "python.org"))
+	assert.Equal(t, "886313e13b8a53729b900c9aee199e5d", NextV5(NamespaceDNS, "python.org"))
+	assert.Equal(t, 3, int(NewV3(NamespaceURL, "test").Version()))
+	assert.Equal(t, 5, int(NewV5(NamespaceURL, "test").Version()))
+}
+
+func TestNextV6V7(t *testing.T) {
+	assert.Len(t, NextV6(), 32)
+	assert.Len(t, NextV7(), 32)
+
+	u6, err := NewV6()
+	require.NoError(t, err)
+	assert.Equal(t, 6, int(u6.Version()))
+
+	prev := ""
+	for i := 0; i < 100; i++ {
+		u7, err := NewV7()
+		require.NoError(t, err)
+		assert.Equal(t, 7, int(u7.Version()))
+		s := u7.String()
+		assert.Greater(t, s, prev)
+		prev = s
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, v := range []int{1, 4, 6, 7} {
+		u, err := New(v)
+		require.NoError(t, err)
+		assert.Equal(t, v, int(u.Version()))
+	}
+
+	_, err := New(3)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestNextBatch(t *testing.T) {
+	us, err := NewBatch(7, 10)
+	require.NoError(t, err)
+	assert.Len(t, us, 10)
+
+	ss, err := NextBatch(4, 5, FormatDashed|FormatUpper)
+	require.NoError(t, err)
+	assert.Len(t, ss, 5)
+	for _, s := range ss {
+		assert.Len(t, s, 36)
+		assert.Equal(t, strings.ToUpper(s), s)
+	}
+
+	_, err = NextBatch(5, 5)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestString(t *testing.T) {
+	u, err := Parse("936dbe97-ec4e-4ded-b459-ef676b566485")
+	require.NoError(t, err)
+
+	cases := []struct {
+		format Format
+		expect string
+	}{
+		{format: FormatCompact, expect: "936dbe97ec4e4dedb459ef676b566485"},
+		{format: FormatDashed, expect: "936dbe97-ec4e-4ded-b459-ef676b566485"},
+		{format: FormatUpper, expect: "936DBE97EC4E4DEDB459EF676B566485"},
+		{format: FormatDashed | FormatUpper, expect: "936DBE97-EC4E-4DED-B459-EF676B566485"},
+		{format: FormatBraces, expect: "{936dbe97ec4e4dedb459ef676b566485}"},
+		{format: FormatDashed | FormatBraces, expect: "{936dbe97-ec4e-4ded-b459-ef676b566485}"},
+		{format: FormatURN, expect: "urn:uuid:936dbe97-ec4e-4ded-b459-ef676b566485"},
+		{format: FormatURN | FormatBraces, expect: "urn:uuid:936dbe97-ec4e-4ded-b459-ef676b566485"},
+	}
+
+	assert.Equal(t, "936dbe97ec4e4dedb459ef676b566485", String(u))
+	for _, c := range cases {
+		s := String(u, c.format)
+		assert.Equal(t, c.expect, s)
+
+		u2, err := Parse(s)
+		require.NoError(t, err)
+		assert.Equal(t, u, u2)
+	}
+}
+
 func TestDecompose(t *testing.T) {
 	expect := "936dbe97-ec4e-4ded-b459-ef676b566485"
 
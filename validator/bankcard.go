@@ -1,8 +1,13 @@
 package validator
 
 import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"io"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // payment card number (PAN), ISO/IEC 7812
@@ -24,6 +29,85 @@ var valueDoubleSumMap = map[int]int{
 	9: 9, // 9 * 2 = 18, 1+ 8 = 9
 }
 
+// ErrInvalidBankCard invalid bank card error.
+var ErrInvalidBankCard = errors.New("validator: invalid bankcard")
+
+// binData embedded bank card bin table, one "bin bank_code bank_name card_type" per line.
+//
+//go:embed data/bin.txt
+var binData string
+
+var (
+	// binMutex bin mutex, used to ensure concurrency security of loading bins.
+	binMutex sync.RWMutex
+	// binInfoMap bin - bank card info map.
+	binInfoMap = make(map[string]BankCardInfo)
+)
+
+func init() {
+	if err := LoadBins(strings.NewReader(binData)); err != nil {
+		panic(err)
+	}
+}
+
+// bankcardDebitAllowUnknown param of the bankcard_debit tag, which also accepts the valid cards
+// whose bin is not in the table, e.g. bankcard_debit=allow_unknown.
+const bankcardDebitAllowUnknown = "allow_unknown"
+
+// CardType bank card type.
+type CardType string
+
+// bank card types.
+const (
+	// CardTypeUnknown unknown card type.
+	CardTypeUnknown CardType = ""
+	// CardTypeDebit debit card.
+	CardTypeDebit CardType = "DC"
+	// CardTypeCredit credit card.
+	CardTypeCredit CardType = "CC"
+	// CardTypeSemiCredit semi-credit card.
+	CardTypeSemiCredit CardType = "SCC"
+	// CardTypePrepaid prepaid card.
+	CardTypePrepaid CardType = "PC"
+)
+
+// cardTypeNameMap card type - name map.
+var cardTypeNameMap = map[CardType]string{
+	CardTypeDebit:      "借记卡",
+	CardTypeCredit:     "信用卡",
+	CardTypeSemiCredit: "准贷记卡",
+	CardTypePrepaid:    "预付费卡",
+}
+
+// String returns the chinese name of the card type.
+func (ct CardType) String() string {
+	if name, ok := cardTypeNameMap[ct]; ok {
+		return name
+	}
+
+	return "未知"
+}
+
+// card schemes.
+const (
+	SchemeUnionPay   = "UnionPay"
+	SchemeVisa       = "Visa"
+	SchemeMastercard = "Mastercard"
+	SchemeAmex       = "American Express"
+	SchemeJCB        = "JCB"
+	SchemeDiners     = "Diners Club"
+	SchemeDiscover   = "Discover"
+)
+
+// BankCardInfo bank card information.
+type BankCardInfo struct {
+	Bin      string   // issuer identification number, empty if the bin is not in the table
+	BankCode string   // bank code, e.g. ICBC
+	BankName string   // bank name, e.g. 中国工商银行
+	CardType CardType // card type
+	Scheme   string   // card scheme, e.g. UnionPay, Visa
+}
+
 // BankCard bank card validator.
 type BankCard string
 
@@ -56,3 +140,115 @@ func (bc BankCard) IsValid() bool {
 
 	return sum%10 == 0
 }
+
+// Info gets the bank card information by the bin,
+// the bank and the card type are empty if the bin is not in the table.
+func (bc BankCard) Info() (*BankCardInfo, error) {
+	if !bc.IsValid() {
+		return nil, ErrInvalidBankCard
+	}
+
+	bcStr := string(bc)
+	info := BankCardInfo{}
+
+	binMutex.RLock()
+	for l := 10; l >= 3; l-- {
+		if bi, ok := binInfoMap[bcStr[:l]]; ok {
+			info = bi
+			break
+		}
+	}
+	binMutex.RUnlock()
+
+	info.Scheme = cardScheme(bcStr)
+
+	return &info, nil
+}
+
+// IsDebit checks the bank card is a valid debit card,
+// the card whose bin is not in the table is rejected because its card type can not be determined.
+func (bc BankCard) IsDebit() bool {
+	info, err := bc.Info()
+	return err == nil && info.CardType == CardTypeDebit
+}
+
+// IsDebitOrUnknown checks the bank card is a valid debit card, or a valid card whose bin is not in the table,
+// so only the cards known as credit, semi-credit or prepaid cards are rejected.
+func (bc BankCard) IsDebitOrUnknown() bool {
+	info, err := bc.Info()
+	return err == nil && (info.CardType == CardTypeDebit || info.CardType == CardTypeUnknown)
+}
+
+// Mask returns the masked bank card for display, which only keeps the first and the last 4 digits,
+// e.g. 6222 **** **** 1234.
+func (bc BankCard) Mask() string {
+	bcStr := string(bc)
+	if len(bcStr) < 8 {
+		return strings.Repeat("*", len(bcStr))
+	}
+
+	return bcStr[:4] + " **** **** " + bcStr[len(bcStr)-4:]
+}
+
+// LoadBins loads the bank card bins from the reader, one "bin bank_code bank_name card_type" per line,
+// blank lines and lines starting with # are ignored.
+// The loaded bins are added to the embedded table and override the existing ones.
+func LoadBins(r io.Reader) error {
+	bins := make(map[string]BankCardInfo)
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 4 || len(fields[0]) < 3 || len(fields[0]) > 10 || !isDigits(fields[0]) {
+			return errors.New("validator: invalid bin line " + line)
+		}
+		ct := CardType(fields[3])
+		if _, ok := cardTypeNameMap[ct]; !ok {
+			return errors.New("validator: invalid bin line " + line)
+		}
+		bins[fields[0]] = BankCardInfo{Bin: fields[0], BankCode: fields[1], BankName: fields[2], CardType: ct}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	binMutex.Lock()
+	defer binMutex.Unlock()
+
+	for bin, info := range bins {
+		binInfoMap[bin] = info
+	}
+
+	return nil
+}
+
+// cardScheme gets the card scheme by the card number prefix.
+func cardScheme(bcStr string) string {
+	prefix2, _ := strconv.Atoi(bcStr[:2])
+	prefix3, _ := strconv.Atoi(bcStr[:3])
+	prefix4, _ := strconv.Atoi(bcStr[:4])
+
+	switch {
+	case bcStr[0] == '4':
+		return SchemeVisa
+	case prefix2 >= 51 && prefix2 <= 55, prefix4 >= 2221 && prefix4 <= 2720:
+		return SchemeMastercard
+	case prefix2 == 34, prefix2 == 37:
+		return SchemeAmex
+	case prefix4 >= 3528 && prefix4 <= 3589:
+		return SchemeJCB
+	case prefix2 == 36, prefix2 == 38, prefix3 >= 300 && prefix3 <= 305:
+		return SchemeDiners
+	case prefix4 == 6011, prefix2 == 65, prefix3 >= 644 && prefix3 <= 649:
+		return SchemeDiscover
+	case prefix2 == 62, prefix2 == 81, bcStr[0] == '9':
+		return SchemeUnionPay
+	default:
+		return ""
+	}
+}
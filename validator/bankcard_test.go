@@ -1,9 +1,11 @@
 package validator
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBankCard_IsValid(t *testing.T) {
@@ -24,3 +26,71 @@ func TestBankCard_IsValid(t *testing.T) {
 		assert.Equal(t, c.expect, get.IsValid())
 	}
 }
+
+func TestBankCard_Info(t *testing.T) {
+	cases := []struct {
+		bankcard string
+		expect   *BankCardInfo
+	}{
+		{bankcard: "6222081203009584273", expect: &BankCardInfo{Bin: "622208", BankCode: "ICBC", BankName: "中国工商银行", CardType: CardTypeDebit, Scheme: SchemeUnionPay}},
+		{bankcard: "6225760008219524", expect: &BankCardInfo{Bin: "622576", BankCode: "CMB", BankName: "招商银行", CardType: CardTypeCredit, Scheme: SchemeUnionPay}},
+		{bankcard: "4367421234567896", expect: &BankCardInfo{Bin: "436742", BankCode: "CCB", BankName: "中国建设银行", CardType: CardTypeDebit, Scheme: SchemeVisa}},
+		{bankcard: "6259650871772098", expect: &BankCardInfo{Scheme: SchemeUnionPay}},
+		{bankcard: "4111111111111111", expect: &BankCardInfo{Scheme: SchemeVisa}},
+		{bankcard: "5400000000000005", expect: &BankCardInfo{Scheme: SchemeMastercard}},
+		{bankcard: "3530111333300000", expect: &BankCardInfo{Scheme: SchemeJCB}},
+		{bankcard: "6221081204209584174", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewBankCard(c.bankcard)
+		info, err := get.Info()
+		if c.expect == nil {
+			require.ErrorIs(t, err, ErrInvalidBankCard)
+			assert.False(t, get.IsDebit())
+			assert.False(t, get.IsDebitOrUnknown())
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, c.expect, info, c.bankcard)
+		assert.Equal(t, c.expect.CardType == CardTypeDebit, get.IsDebit(), c.bankcard)
+		assert.Equal(t, c.expect.CardType != CardTypeCredit, get.IsDebitOrUnknown(), c.bankcard)
+	}
+
+	assert.Equal(t, "借记卡", CardTypeDebit.String())
+	assert.Equal(t, "未知", CardTypeUnknown.String())
+
+	assert.NoError(t, VerifyVar("6222081203009584273", "bankcard_debit"))
+	assert.Error(t, VerifyVar("6225760008219524", "bankcard_debit"))
+	// the card type of the unknown bin can not be determined, which is only accepted explicitly
+	assert.Error(t, VerifyVar("6259650871772098", "bankcard_debit"))
+	assert.NoError(t, VerifyVar("6259650871772098", "bankcard_debit=allow_unknown"))
+	assert.NoError(t, VerifyVar("6222081203009584273", "bankcard_debit=allow_unknown"))
+	assert.Error(t, VerifyVar("6225760008219524", "bankcard_debit=allow_unknown"))
+	assert.Error(t, VerifyVar("6221081204209584174", "bankcard_debit=allow_unknown"))
+}
+
+func TestBankCard_Mask(t *testing.T) {
+	assert.Equal(t, "6222 **** **** 4273", NewBankCard("6222081203009584273").Mask())
+	assert.Equal(t, "6225 **** **** 9524", NewBankCard("6225760008219524").Mask())
+	assert.Equal(t, "****", NewBankCard("1234").Mask())
+}
+
+func TestLoadBins(t *testing.T) {
+	err := LoadBins(strings.NewReader("# comment\n\n625965 TEST 测试银行 CC\n"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		binMutex.Lock()
+		delete(binInfoMap, "625965")
+		binMutex.Unlock()
+	})
+
+	info, err := NewBankCard("6259650871772098").Info()
+	require.NoError(t, err)
+	assert.Equal(t, "测试银行", info.BankName)
+	assert.Equal(t, CardTypeCredit, info.CardType)
+
+	require.Error(t, LoadBins(strings.NewReader("625965 TEST 测试银行 XX\n")))
+	require.Error(t, LoadBins(strings.NewReader("62 TEST 测试银行 DC\n")))
+	require.Error(t, LoadBins(strings.NewReader("625965 TEST DC\n")))
+}
@@ -0,0 +1,315 @@
+package validator
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMaxMemory default max memory of parsing the multipart form, 32 MB.
+const DefaultMaxMemory = 32 << 20
+
+// binding tags.
+const (
+	tagPath   = "path"
+	tagForm   = "form"
+	tagHeader = "header"
+)
+
+var (
+	// ErrInvalidBindTarget invalid bind target error.
+	ErrInvalidBindTarget = errors.New("validator: bind target must be a non-nil struct pointer")
+	// ErrInvalidBody invalid request body error.
+	ErrInvalidBody = errors.New("validator: invalid request body")
+	// ErrInvalidParam invalid request parameter error.
+	ErrInvalidParam = errors.New("validator: invalid request parameter")
+
+	durationType        = reflect.TypeOf(time.Duration(0))
+	fileHeaderType      = reflect.TypeOf((*multipart.FileHeader)(nil))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// PathParamFunc gets the path parameter of the request by name, e.g. chi.URLParam.
+type PathParamFunc func(r *http.Request, name string) string
+
+// SetPathParamFunc sets the path parameter getter used by Bind,
+// the fields with path tag are ignored if the getter is not set.
+func (v *Validator) SetPathParamFunc(fn PathParamFunc) {
+	v.pathParam = fn
+}
+
+// Bind binds the request to the data and validates it, the data must be a non-nil struct pointer.
+// The json body is decoded by the json tags, and the fields with the following tags are populated:
+// path for the path parameters, form for the url query and the form body, header for the headers.
+// The body without Content-Type is decoded as json, and the body of the other media types
+// is rejected by ErrInvalidBody.
+// The scalar values are parsed by the strconv package within the range of the field type,
+// and the bool values also accept on, off, yes and no, e.g. on sent by the html checkbox,
+// the encoding.TextUnmarshaler, time.Duration and *multipart.FileHeader fields are also supported.
+// The temporary files of the multipart form are removed unless the uploaded files are bound to the data,
+// in which case they are removed by the http server after the handler returns, or by r.MultipartForm.RemoveAll.
+// The validation errors are translated like Validate and returned as ValidationErrors.
+func (v *Validator) Bind(r *http.Request, data any) (err error) {
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidBindTarget
+	}
+
+	b := &binder{r: r, pathParam: v.pathParam, query: r.URL.Query()}
+	defer func() {
+		if r.MultipartForm != nil && (err != nil || !b.filesBound) {
+			_ = r.MultipartForm.RemoveAll()
+		}
+	}()
+	if err = b.parseBody(data); err != nil {
+		return err
+	}
+	if err = b.bindStruct(rv.Elem()); err != nil {
+		return err
+	}
+
+	return v.translate(v.V.Struct(data), data, v.Translator(requestLocales(r)...))
+}
+
+// binder binds the request values to the struct fields.
+type binder struct {
+	r         *http.Request
+	pathParam PathParamFunc
+	query     url.Values
+	form      url.Values
+	files     map[string][]*multipart.FileHeader
+	// filesBound reports whether the uploaded files are bound to the data.
+	filesBound bool
+}
+
+// parseBody decodes the json body to the data, or parses the form body.
+func (b *binder) parseBody(data any) error {
+	if b.r.Body == nil || b.r.Body == http.NoBody {
+		return nil
+	}
+
+	contentType := b.r.Header.Get("Content-Type")
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case contentType == "", mediaType == "application/json", strings.HasSuffix(mediaType, "+json"):
+		if err := json.NewDecoder(b.r.Body).Decode(data); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("%w, %v", ErrInvalidBody, err)
+		}
+	case mediaType == "multipart/form-data":
+		if err := b.r.ParseMultipartForm(DefaultMaxMemory); err != nil {
+			return fmt.Errorf("%w, %v", ErrInvalidBody, err)
+		}
+		b.form = url.Values(b.r.MultipartForm.Value)
+		b.files = b.r.MultipartForm.File
+	case mediaType == "application/x-www-form-urlencoded":
+		if err := b.r.ParseForm(); err != nil {
+			return fmt.Errorf("%w, %v", ErrInvalidBody, err)
+		}
+		b.form = b.r.PostForm
+	default:
+		return fmt.Errorf("%w, unsupported media type %s", ErrInvalidBody, contentType)
+	}
+
+	return nil
+}
+
+// bindStruct binds the request values to the fields of the struct,
+// the embedded structs are bound recursively.
+func (b *binder) bindStruct(rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf, fv := rt.Field(i), rv.Field(i)
+		if sf.Anonymous && indirectType(sf.Type).Kind() == reflect.Struct {
+			if fv.Kind() == reflect.Ptr {
+				if !fv.CanSet() {
+					continue
+				}
+				if fv.IsNil() {
+					fv.Set(reflect.New(sf.Type.Elem()))
+				}
+				fv = fv.Elem()
+			}
+			if err := b.bindStruct(fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if !fv.CanSet() {
+			continue
+		}
+
+		for _, tag := range []string{tagPath, tagForm, tagHeader} {
+			name := strings.SplitN(sf.Tag.Get(tag), ",", 2)[0]
+			if name == "" || name == "-" {
+				continue
+			}
+
+			if files, ok := b.files[name]; ok && tag == tagForm && isFileField(sf.Type) {
+				setFiles(fv, files)
+				b.filesBound = true
+				continue
+			}
+			values := b.lookup(tag, name)
+			if len(values) == 0 {
+				continue
+			}
+			if err := setValues(fv, values); err != nil {
+				return fmt.Errorf("%w, %s: %v", ErrInvalidParam, name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// lookup looks up the request values by the tag and the name,
+// the form body values take precedence over the url query values.
+func (b *binder) lookup(tag, name string) []string {
+	switch tag {
+	case tagPath:
+		if b.pathParam != nil {
+			if value := b.pathParam(b.r, name); value != "" {
+				return []string{value}
+			}
+		}
+	case tagForm:
+		if values, ok := b.form[name]; ok {
+			return values
+		}
+		return b.query[name]
+	case tagHeader:
+		return b.r.Header.Values(name)
+	}
+
+	return nil
+}
+
+// isFileField reports whether the field type is *multipart.FileHeader or []*multipart.FileHeader.
+func isFileField(typ reflect.Type) bool {
+	return typ == fileHeaderType || (typ.Kind() == reflect.Slice && typ.Elem() == fileHeaderType)
+}
+
+// setFiles sets the uploaded files to the field.
+func setFiles(fv reflect.Value, files []*multipart.FileHeader) {
+	if fv.Kind() == reflect.Slice {
+		fv.Set(reflect.ValueOf(files))
+		return
+	}
+	if len(files) > 0 {
+		fv.Set(reflect.ValueOf(files[0]))
+	}
+}
+
+// setValues sets the values to the field, the slice field is set by all values,
+// and the others are set by the first value.
+func setValues(fv reflect.Value, values []string) error {
+	if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 &&
+		!reflect.PtrTo(fv.Type()).Implements(textUnmarshalerType) {
+		s := reflect.MakeSlice(fv.Type(), len(values), len(values))
+		for i, value := range values {
+			if err := setValue(s.Index(i), value); err != nil {
+				return err
+			}
+		}
+		fv.Set(s)
+		return nil
+	}
+
+	return setValue(fv, values[0])
+}
+
+// setValue sets the string value to the field,
+// the numbers out of the range of the field type are rejected.
+func setValue(fv reflect.Value, value string) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return setValue(fv.Elem(), value)
+	}
+
+	if tu, ok := fv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(value))
+	}
+	if fv.Type() == durationType {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(int64(d))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(value)
+	case reflect.Bool:
+		b, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(value, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+	case reflect.Slice:
+		// []byte
+		fv.SetBytes([]byte(value))
+	default:
+		return fmt.Errorf("unsupported type %s", fv.Type())
+	}
+
+	return nil
+}
+
+// parseBool parses the bool value like strconv.ParseBool,
+// on, off, yes and no in any case are also accepted.
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "on", "yes":
+		return true, nil
+	case "off", "no":
+		return false, nil
+	default:
+		return strconv.ParseBool(value)
+	}
+}
+
+// requestLocales gets the locales of the request, the locale set by WithLocale on the request context
+// takes precedence over the locales of the Accept-Language header.
+func requestLocales(r *http.Request) []string {
+	var locales []string
+	if r != nil {
+		if locale, ok := localeFromContext(r.Context()); ok {
+			locales = append(locales, locale)
+		}
+		locales = append(locales, parseAcceptLanguage(r.Header.Get("Accept-Language"))...)
+	}
+
+	return locales
+}
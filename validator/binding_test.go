@@ -0,0 +1,211 @@
+package validator
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _BindPage struct {
+	Page     int `form:"page" validate:"gte=1"`
+	PageSize int `form:"page_size" validate:"gte=1,lte=100"`
+}
+
+type _BindReq struct {
+	Id        int64                 `path:"id" validate:"gt=0"`
+	Name      string                `json:"name" validate:"required"`
+	Mobile    string                `json:"mobile" validate:"omitempty,mobile"`
+	Tags      []string              `form:"tag"`
+	Enabled   *bool                 `form:"enabled"`
+	Score     float64               `form:"score"`
+	Since     time.Time             `form:"since"`
+	Timeout   time.Duration         `form:"timeout"`
+	Token     string                `header:"X-Token" validate:"required"`
+	Languages []string              `header:"Accept-Language"`
+	File      *multipart.FileHeader `form:"file"`
+	_BindPage
+}
+
+func newBindValidator(t *testing.T) *Validator {
+	v, err := NewValidator()
+	require.NoError(t, err)
+	v.SetPathParamFunc(func(r *http.Request, name string) string {
+		if name == "id" {
+			return strings.TrimPrefix(r.URL.Path, "/users/")
+		}
+		return ""
+	})
+
+	return v
+}
+
+func TestValidator_BindJSON(t *testing.T) {
+	v := newBindValidator(t)
+
+	r := httptest.NewRequest(http.MethodPost,
+		"/users/42?page=2&page_size=20&tag=a&tag=b&enabled=true&score=9.5&since=2024-01-02T03:04:05Z&timeout=1m30s",
+		strings.NewReader(`{"name":"sliveryou","mobile":"13800138000"}`))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	r.Header.Set("X-Token", "token")
+	r.Header.Set("Accept-Language", "en")
+
+	var req _BindReq
+	require.NoError(t, v.Bind(r, &req))
+	assert.Equal(t, int64(42), req.Id)
+	assert.Equal(t, "sliveryou", req.Name)
+	assert.Equal(t, "13800138000", req.Mobile)
+	assert.Equal(t, []string{"a", "b"}, req.Tags)
+	require.NotNil(t, req.Enabled)
+	assert.True(t, *req.Enabled)
+	assert.Equal(t, 9.5, req.Score)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), req.Since)
+	assert.Equal(t, 90*time.Second, req.Timeout)
+	assert.Equal(t, "token", req.Token)
+	assert.Equal(t, []string{"en"}, req.Languages)
+	assert.Equal(t, 2, req.Page)
+	assert.Equal(t, 20, req.PageSize)
+}
+
+func TestValidator_BindForm(t *testing.T) {
+	v := newBindValidator(t)
+
+	r := httptest.NewRequest(http.MethodPost, "/users/7?page=2&page_size=20",
+		strings.NewReader("page=3&page_size=200&tag=c"))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("X-Token", "token")
+	r.Header.Set("Accept-Language", "en")
+
+	var req _BindReq
+	err := v.Bind(r, &req)
+	var ves ValidationErrors
+	require.True(t, errors.As(err, &ves))
+	require.Len(t, ves, 2)
+	assert.Equal(t, "name", ves[0].Namespace)
+	assert.Equal(t, "name is a required field", ves[0].Message)
+	assert.Equal(t, "PageSize", ves[1].Namespace)
+	assert.Equal(t, "lte", ves[1].Tag)
+	assert.Equal(t, int64(7), req.Id)
+	assert.Equal(t, []string{"c"}, req.Tags)
+	assert.Equal(t, 3, req.Page)
+}
+
+func TestValidator_BindMultipart(t *testing.T) {
+	v := newBindValidator(t)
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	require.NoError(t, w.WriteField("page", "1"))
+	require.NoError(t, w.WriteField("page_size", "10"))
+	fw, err := w.CreateFormFile("file", "a.txt")
+	require.NoError(t, err)
+	_, err = fw.Write([]byte("hello"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/users/1", body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	r.Header.Set("X-Token", "token")
+
+	var req _BindReq
+	err = v.Bind(r, &req)
+	require.EqualError(t, err, "name为必填字段")
+	assert.Equal(t, 1, req.Page)
+	assert.Equal(t, 10, req.PageSize)
+	require.NotNil(t, req.File)
+	assert.Equal(t, "a.txt", req.File.Filename)
+	assert.Equal(t, int64(5), req.File.Size)
+	f, err := req.File.Open()
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestValidator_BindCheckbox(t *testing.T) {
+	v := newBindValidator(t)
+
+	var form struct {
+		Agree     bool  `form:"agree"`
+		Subscribe bool  `form:"subscribe"`
+		Remember  *bool `form:"remember"`
+	}
+	for _, c := range []struct {
+		value  string
+		expect bool
+	}{
+		{value: "on", expect: true}, {value: "ON", expect: true}, {value: "yes", expect: true},
+		{value: "true", expect: true}, {value: "1", expect: true},
+		{value: "off", expect: false}, {value: "No", expect: false}, {value: "0", expect: false},
+	} {
+		r := httptest.NewRequest(http.MethodPost, "/users/1", strings.NewReader("agree="+c.value+"&remember="+c.value))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		require.NoError(t, v.Bind(r, &form), c.value)
+		assert.Equal(t, c.expect, form.Agree, c.value)
+		require.NotNil(t, form.Remember)
+		assert.Equal(t, c.expect, *form.Remember, c.value)
+		assert.False(t, form.Subscribe)
+	}
+}
+
+func TestValidator_BindNoContentType(t *testing.T) {
+	v := newBindValidator(t)
+
+	r := httptest.NewRequest(http.MethodPost, "/users/1?page=1&page_size=10", strings.NewReader(`{"name":"sliveryou"}`))
+	r.Header.Set("X-Token", "token")
+	var req _BindReq
+	require.NoError(t, v.Bind(r, &req))
+	assert.Equal(t, "sliveryou", req.Name)
+
+	r = httptest.NewRequest(http.MethodPost, "/users/1", strings.NewReader(`{"name":"sliveryou"}`))
+	r.Header.Set("Content-Type", "text/plain")
+	require.ErrorIs(t, v.Bind(r, &req), ErrInvalidBody)
+}
+
+func TestValidator_BindError(t *testing.T) {
+	v := newBindValidator(t)
+
+	r := httptest.NewRequest(http.MethodPost, "/users/1", strings.NewReader(`{"name":`))
+	r.Header.Set("Content-Type", "application/json")
+	var req _BindReq
+	require.ErrorIs(t, v.Bind(r, &req), ErrInvalidBody)
+
+	r = httptest.NewRequest(http.MethodGet, "/users/1?since=yesterday", nil)
+	require.ErrorIs(t, v.Bind(r, &req), ErrInvalidParam)
+
+	r = httptest.NewRequest(http.MethodGet, "/users/1?timeout=1x", nil)
+	require.ErrorIs(t, v.Bind(r, &req), ErrInvalidParam)
+
+	r = httptest.NewRequest(http.MethodGet, "/users/1?page=abc", nil)
+	require.ErrorIs(t, v.Bind(r, &req), ErrInvalidParam)
+
+	r = httptest.NewRequest(http.MethodGet, "/users/1?enabled=maybe", nil)
+	require.ErrorIs(t, v.Bind(r, &req), ErrInvalidParam)
+
+	var ranged struct {
+		Age   int8    `form:"age"`
+		Count uint16  `form:"count"`
+		Ratio float32 `form:"ratio"`
+	}
+	r = httptest.NewRequest(http.MethodGet, "/users/1?age=300", nil)
+	require.ErrorIs(t, v.Bind(r, &ranged), ErrInvalidParam)
+	r = httptest.NewRequest(http.MethodGet, "/users/1?count=-1", nil)
+	require.ErrorIs(t, v.Bind(r, &ranged), ErrInvalidParam)
+	r = httptest.NewRequest(http.MethodGet, "/users/1?ratio=1e40", nil)
+	require.ErrorIs(t, v.Bind(r, &ranged), ErrInvalidParam)
+	r = httptest.NewRequest(http.MethodGet, "/users/1?age=-128&count=65535&ratio=0.5", nil)
+	require.NoError(t, v.Bind(r, &ranged))
+	assert.Equal(t, int8(-128), ranged.Age)
+	assert.Equal(t, uint16(65535), ranged.Count)
+	assert.Equal(t, float32(0.5), ranged.Ratio)
+
+	require.ErrorIs(t, v.Bind(r, req), ErrInvalidBindTarget)
+	require.ErrorIs(t, v.Bind(r, (*_BindReq)(nil)), ErrInvalidBindTarget)
+	s := "abc"
+	require.ErrorIs(t, v.Bind(r, &s), ErrInvalidBindTarget)
+}
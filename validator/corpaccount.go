@@ -15,3 +15,9 @@ func (ca CorpAccount) IsValid() bool {
 	caStr := strings.ToUpper(string(ca))
 	return corpaccountRegex.MatchString(caStr)
 }
+
+// Mask returns the masked corp account for display, which only keeps the first and the last 4 digits,
+// e.g. 1234*************5678.
+func (ca CorpAccount) Mask() string {
+	return MaskKeep(string(ca), 4, 4)
+}
@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	validator "github.com/go-playground/validator/v10"
+)
+
+// CodeValidationFailed code of the validation errors.
+const CodeValidationFailed = "VALIDATION_FAILED"
+
+// FieldError structured validation error of a field.
+type FieldError struct {
+	Namespace string `json:"namespace"`       // full path of the field, e.g. items[2].price, empty for a variable
+	Field     string `json:"field"`           // json field name, e.g. price, empty for a variable
+	Tag       string `json:"tag"`             // validation tag, e.g. required
+	Param     string `json:"param,omitempty"` // parameter of the tag, e.g. 10 of max=10
+	Message   string `json:"message"`         // translated message
+	Code      string `json:"code"`            // stable error code of the tag, e.g. VALIDATION_REQUIRED
+}
+
+// ErrorCode returns the stable error code of the validation tag, e.g. VALIDATION_REQUIRED.
+func ErrorCode(tag string) string {
+	return "VALIDATION_" + strings.ToUpper(tag)
+}
+
+// ValidationErrors represents the structured validation errors.
+type ValidationErrors []*FieldError
+
+// Error returns the validation error string and implement the error interface,
+// by default, only the validation error of the first field is returned.
+func (ves ValidationErrors) Error() string {
+	if len(ves) > 0 {
+		return ves[0].Message
+	}
+
+	return ErrUnexpected.Error()
+}
+
+// Messages returns the translated messages of all validation errors.
+func (ves ValidationErrors) Messages() []string {
+	messages := make([]string, 0, len(ves))
+	for _, ve := range ves {
+		messages = append(messages, ve.Message)
+	}
+
+	return messages
+}
+
+// MarshalJSON marshals the validation errors to the json suitable for the http 400 response body, e.g.
+// {"code":"VALIDATION_FAILED","message":"price必须大于0","errors":[{"namespace":"items[2].price",...}]}.
+func (ves ValidationErrors) MarshalJSON() ([]byte, error) {
+	errs := []*FieldError(ves)
+	if errs == nil {
+		errs = []*FieldError{}
+	}
+
+	return json.Marshal(struct {
+		Code    string        `json:"code"`
+		Message string        `json:"message"`
+		Errors  []*FieldError `json:"errors"`
+	}{
+		Code:    CodeValidationFailed,
+		Message: ves.Error(),
+		Errors:  errs,
+	})
+}
+
+// newValidationErrors translates the validation errors to the structured validation errors,
+// the namespaces are resolved to the json paths by the type of root if it is not nil,
+// otherwise the struct field names are used.
+func newValidationErrors(ves validator.ValidationErrors, root interface{}, t ut.Translator) ValidationErrors {
+	var rootType reflect.Type
+	if root != nil {
+		rootType = reflect.TypeOf(root)
+	}
+
+	es := make(ValidationErrors, 0, len(ves))
+	for _, ve := range ves {
+		namespace, field := jsonNamespace(rootType, ve.StructNamespace())
+		es = append(es, &FieldError{
+			Namespace: namespace,
+			Field:     field,
+			Tag:       ve.Tag(),
+			Param:     ve.Param(),
+			Message:   strings.TrimSpace(ve.Translate(t)),
+			Code:      ErrorCode(ve.Tag()),
+		})
+	}
+
+	return es
+}
+
+// jsonNamespace resolves the struct namespace like User.Items[2].Price to the json path like items[2].price,
+// the root type name is removed and the embedded structs without json name are flattened,
+// it also returns the json name of the last field.
+func jsonNamespace(rootType reflect.Type, structNamespace string) (string, string) {
+	segments := splitNamespace(structNamespace)
+	if len(segments) < 2 {
+		return "", ""
+	}
+
+	typ := indirectType(rootType)
+	var path []string
+	var field string
+	for _, segment := range segments[1:] {
+		name, index := segment, ""
+		if i := strings.IndexByte(segment, '['); i >= 0 {
+			name, index = segment[:i], segment[i:]
+		}
+
+		field = name
+		if typ != nil && typ.Kind() == reflect.Struct {
+			if sf, ok := typ.FieldByName(name); ok {
+				jsonName := strings.SplitN(sf.Tag.Get("json"), ",", 2)[0]
+				typ = indirectType(sf.Type)
+				for i := strings.Count(index, "["); i > 0 && typ != nil; i-- {
+					typ = indirectType(typ.Elem())
+				}
+				if sf.Anonymous && jsonName == "" && index == "" {
+					continue
+				}
+				if jsonName != "" && jsonName != "-" {
+					field = jsonName
+				}
+			} else {
+				typ = nil
+			}
+		}
+
+		path = append(path, field+index)
+	}
+
+	return strings.Join(path, "."), field
+}
+
+// splitNamespace splits the namespace by the dots which are not in the brackets of map keys.
+func splitNamespace(namespace string) []string {
+	if namespace == "" {
+		return nil
+	}
+
+	var segments []string
+	depth, start := 0, 0
+	for i := 0; i < len(namespace); i++ {
+		switch namespace[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				segments = append(segments, namespace[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(segments, namespace[start:])
+}
+
+// indirectType returns the element type of the pointer type, nil is returned as it is.
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
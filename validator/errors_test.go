@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _OrderItem struct {
+	Price int `json:"price" validate:"gt=0" label:"价格"`
+}
+
+type _OrderReq struct {
+	Mobile string                 `json:"mobile,omitempty" validate:"required,mobile" label:"手机号"`
+	Items  []*_OrderItem          `json:"items" validate:"required,dive"`
+	Extra  map[string]*_OrderItem `validate:"dive"`
+	_PageInfo
+}
+
+func TestValidationErrors(t *testing.T) {
+	req := &_OrderReq{
+		Mobile:    "123",
+		Items:     []*_OrderItem{{Price: 1}, {Price: 1}, {Price: 0}},
+		Extra:     map[string]*_OrderItem{"a.b": {Price: -1}},
+		_PageInfo: _PageInfo{Page: 1, PageSize: 0},
+	}
+
+	err := Verify(req)
+	var ves ValidationErrors
+	require.True(t, errors.As(err, &ves))
+	require.Len(t, ves, 4)
+
+	assert.Equal(t, &FieldError{
+		Namespace: "mobile", Field: "mobile", Tag: "mobile",
+		Message: "手机号必须是有效的手机号", Code: "VALIDATION_MOBILE",
+	}, ves[0])
+	assert.Equal(t, &FieldError{
+		Namespace: "items[2].price", Field: "price", Tag: "gt", Param: "0",
+		Message: "价格必须大于0", Code: "VALIDATION_GT",
+	}, ves[1])
+	assert.Equal(t, "Extra[a.b].price", ves[2].Namespace)
+	assert.Equal(t, "price", ves[2].Field)
+	assert.Equal(t, &FieldError{
+		Namespace: "PageSize", Field: "PageSize", Tag: "required",
+		Message: "每条页数为必填字段", Code: "VALIDATION_REQUIRED",
+	}, ves[3])
+
+	assert.Equal(t, "手机号必须是有效的手机号", err.Error())
+	assert.Equal(t, "手机号必须是有效的手机号,价格必须大于0,价格必须大于0,每条页数为必填字段", ParseErr(err))
+
+	b, err := json.Marshal(ves[:2])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"code": "VALIDATION_FAILED",
+		"message": "手机号必须是有效的手机号",
+		"errors": [
+			{"namespace": "mobile", "field": "mobile", "tag": "mobile", "message": "手机号必须是有效的手机号", "code": "VALIDATION_MOBILE"},
+			{"namespace": "items[2].price", "field": "price", "tag": "gt", "param": "0", "message": "价格必须大于0", "code": "VALIDATION_GT"}
+		]
+	}`, string(b))
+
+	b, err = json.Marshal(ValidationErrors(nil))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"code": "VALIDATION_FAILED", "message": "参数校验出错", "errors": []}`, string(b))
+}
+
+func TestValidator_TranslateNamespace(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.Translate(v.V.Struct(&_OrderReq{Mobile: "13800138000", Items: []*_OrderItem{{Price: 0}}, _PageInfo: _PageInfo{Page: 1, PageSize: 1}}), "en")
+	var ves ValidationErrors
+	require.True(t, errors.As(err, &ves))
+	require.Len(t, ves, 1)
+	assert.Equal(t, "Items[0].Price", ves[0].Namespace)
+	assert.Equal(t, "Price", ves[0].Field)
+	assert.Equal(t, "价格 must be greater than 0", ves[0].Message)
+
+	err = VerifyVar("abc", "mobile")
+	require.True(t, errors.As(err, &ves))
+	assert.Equal(t, &FieldError{Tag: "mobile", Message: "必须是有效的手机号", Code: "VALIDATION_MOBILE"}, ves[0])
+}
@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// global trade item number (GTIN) of GS1, including EAN-8, UPC-A (GTIN-12), EAN-13 (GTIN-13) and GTIN-14
+// the last digit is the check digit, the digits from right to left excluding the check digit
+// are weighted 3 and 1 alternately, and the check digit makes the weighted sum divisible by 10
+// the first 3 digits of EAN-13 are the gs1 prefix, which represents the issuing gs1 member organization
+// general specifications: https://www.gs1.org/standards/barcodes-epcrfid-id-keys/gs1-general-specifications
+
+// ErrInvalidGTIN invalid gtin error.
+var ErrInvalidGTIN = errors.New("validator: invalid gtin")
+
+var gtinRegex = regexp.MustCompile(`^(?:[0-9]{8}|[0-9]{12,14})$`)
+
+// gtin formats.
+const (
+	GTINFormatEAN8   = "EAN-8"
+	GTINFormatUPCA   = "UPC-A"
+	GTINFormatEAN13  = "EAN-13"
+	GTINFormatGTIN14 = "GTIN-14"
+)
+
+// gs1PrefixRanges gs1 prefix ranges - member organization of the common prefixes.
+var gs1PrefixRanges = []struct {
+	from, to string
+	name     string
+}{
+	{from: "000", to: "019", name: "美国和加拿大"},
+	{from: "030", to: "039", name: "美国和加拿大"},
+	{from: "060", to: "139", name: "美国和加拿大"},
+	{from: "300", to: "379", name: "法国"},
+	{from: "400", to: "440", name: "德国"},
+	{from: "450", to: "459", name: "日本"},
+	{from: "471", to: "471", name: "中国台湾"},
+	{from: "489", to: "489", name: "中国香港"},
+	{from: "490", to: "499", name: "日本"},
+	{from: "500", to: "509", name: "英国"},
+	{from: "690", to: "699", name: "中国"},
+	{from: "880", to: "881", name: "韩国"},
+	{from: "958", to: "958", name: "中国澳门"},
+	{from: "977", to: "977", name: "连续出版物(ISSN)"},
+	{from: "978", to: "979", name: "图书(ISBN)"},
+}
+
+// GTINInfo parsed gtin information.
+type GTINInfo struct {
+	Format string // gtin format, e.g. EAN-13
+	Prefix string // 3 digits gs1 prefix of the 13 digits form, empty for EAN-8
+	Issuer string // gs1 member organization of the prefix, empty if the prefix is not in the table
+	Check  string // check digit
+}
+
+// GTIN gtin validator.
+type GTIN string
+
+// NewGTIN new a gtin validator.
+func NewGTIN(gtin string) GTIN {
+	return GTIN(gtin)
+}
+
+// IsValid checks the gtin is valid.
+func (g GTIN) IsValid() bool {
+	_, err := g.Parse()
+	return err == nil
+}
+
+// Parse parses the gtin, the format is decided by the length.
+func (g GTIN) Parse() (*GTINInfo, error) {
+	gStr := strings.TrimSpace(string(g))
+	if !gtinRegex.MatchString(gStr) || gs1CheckDigit(gStr[:len(gStr)-1]) != gStr[len(gStr)-1] {
+		return nil, ErrInvalidGTIN
+	}
+
+	info := &GTINInfo{Check: gStr[len(gStr)-1:]}
+	switch len(gStr) {
+	case 8:
+		info.Format = GTINFormatEAN8
+		return info, nil
+	case 12:
+		info.Format = GTINFormatUPCA
+		gStr = "0" + gStr
+	case 13:
+		info.Format = GTINFormatEAN13
+	default:
+		info.Format = GTINFormatGTIN14
+		gStr = gStr[1:]
+	}
+
+	info.Prefix = gStr[:3]
+	for _, r := range gs1PrefixRanges {
+		if info.Prefix >= r.from && info.Prefix <= r.to {
+			info.Issuer = r.name
+			break
+		}
+	}
+
+	return info, nil
+}
+
+// To14 converts the gtin to GTIN-14 by padding zeros on the left.
+func (g GTIN) To14() (string, error) {
+	if !g.IsValid() {
+		return "", ErrInvalidGTIN
+	}
+
+	gStr := strings.TrimSpace(string(g))
+
+	return strings.Repeat("0", 14-len(gStr)) + gStr, nil
+}
+
+// gs1CheckDigit computes the gs1 check digit of the digits excluding the check digit.
+func gs1CheckDigit(s string) uint8 {
+	sum := 0
+	for index := len(s) - 1; index >= 0; index-- {
+		value := int(s[index] - '0')
+		if (len(s)-index)%2 == 1 {
+			value *= 3
+		}
+		sum += value
+	}
+
+	return uint8('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGTIN_Parse(t *testing.T) {
+	cases := []struct {
+		gtin   string
+		expect *GTINInfo
+	}{
+		{gtin: "96385074", expect: &GTINInfo{Format: GTINFormatEAN8, Check: "4"}},
+		{gtin: "036000291452", expect: &GTINInfo{Format: GTINFormatUPCA, Prefix: "003", Issuer: "美国和加拿大", Check: "2"}},
+		{gtin: "6901234567892", expect: &GTINInfo{Format: GTINFormatEAN13, Prefix: "690", Issuer: "中国", Check: "2"}},
+		{gtin: "9780306406157", expect: &GTINInfo{Format: GTINFormatEAN13, Prefix: "978", Issuer: "图书(ISBN)", Check: "7"}},
+		{gtin: "10614141000415", expect: &GTINInfo{Format: GTINFormatGTIN14, Prefix: "061", Issuer: "美国和加拿大", Check: "5"}},
+		{gtin: "2001234567893", expect: &GTINInfo{Format: GTINFormatEAN13, Prefix: "200", Check: "3"}},
+		{gtin: "6901234567893", expect: nil},
+		{gtin: "690123456789", expect: nil},
+		{gtin: "123456789", expect: nil},
+		{gtin: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewGTIN(c.gtin)
+		assert.Equal(t, c.expect != nil, get.IsValid(), c.gtin)
+		info, err := get.Parse()
+		if c.expect != nil {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid gtin")
+		}
+		assert.Equal(t, c.expect, info, c.gtin)
+	}
+
+	gtin, err := NewGTIN("036000291452").To14()
+	require.NoError(t, err)
+	assert.Equal(t, "00036000291452", gtin)
+	_, err = NewGTIN("036000291453").To14()
+	require.EqualError(t, err, "validator: invalid gtin")
+}
@@ -0,0 +1,202 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// identity cards of hong kong, macau and taiwan
+// hong kong identity card: 1 or 2 letters + 6 digits + check digit in parentheses, e.g. A123456(3),
+// the check digit is 11 - the weighted sum of the first 8 characters with weights 9 to 2 modulo 11,
+// 10 is represented by A and 11 by 0, the letters are valued A=10 to Z=35 and the absent letter is valued 36
+// macau identity card: 1, 5 or 7 + 6 digits + check digit in parentheses, e.g. 1234567(8), the check digit is not public
+// taiwan identity card: 1 letter of the issuing county + gender digit + 8 digits, e.g. A123456789,
+// the weighted sum of the 2 digits value of the letter and the 9 digits with weights 1, 9, 8 to 1, 1 must be divisible by 10
+
+var (
+	// ErrInvalidHKIdCard invalid hong kong identity card error.
+	ErrInvalidHKIdCard = errors.New("validator: invalid hong kong idcard")
+	// ErrInvalidMacauIdCard invalid macau identity card error.
+	ErrInvalidMacauIdCard = errors.New("validator: invalid macau idcard")
+	// ErrInvalidTaiwanIdCard invalid taiwan identity card error.
+	ErrInvalidTaiwanIdCard = errors.New("validator: invalid taiwan idcard")
+
+	hkIdCardRegex     = regexp.MustCompile(`^([A-Z]{1,2})([0-9]{6})\(?([0-9A])\)?$`)
+	macauIdCardRegex  = regexp.MustCompile(`^([157])([0-9]{6})\(?([0-9])\)?$`)
+	taiwanIdCardRegex = regexp.MustCompile(`^[A-Z][1289][0-9]{8}$`)
+)
+
+// letter - value, county map of taiwan identity card,
+// the values are not in alphabetical order since the letters I, O, W and Z were appended later.
+var (
+	taiwanLetterValueMap = map[uint8]int{
+		'A': 10, 'B': 11, 'C': 12, 'D': 13, 'E': 14, 'F': 15, 'G': 16, 'H': 17, 'I': 34,
+		'J': 18, 'K': 19, 'L': 20, 'M': 21, 'N': 22, 'O': 35, 'P': 23, 'Q': 24, 'R': 25,
+		'S': 26, 'T': 27, 'U': 28, 'V': 29, 'W': 32, 'X': 30, 'Y': 31, 'Z': 33,
+	}
+	taiwanLetterCountyMap = map[uint8]string{
+		'A': "台北市", 'B': "台中市", 'C': "基隆市", 'D': "台南市", 'E': "高雄市", 'F': "新北市",
+		'G': "宜兰县", 'H': "桃园市", 'I': "嘉义市", 'J': "新竹县", 'K': "苗栗县", 'L': "台中县",
+		'M': "南投县", 'N': "彰化县", 'O': "新竹市", 'P': "云林县", 'Q': "嘉义县", 'R': "台南县",
+		'S': "高雄县", 'T': "屏东县", 'U': "花莲县", 'V': "台东县", 'W': "金门县", 'X': "澎湖县",
+		'Y': "阳明山", 'Z': "连江县",
+	}
+)
+
+// HKIdCardInfo parsed hong kong identity card information.
+type HKIdCardInfo struct {
+	Prefix string // 1 or 2 letters prefix
+	Number string // 6 digits number
+	Check  string // check digit, 0-9 or A
+}
+
+// String returns the hong kong identity card in the form of A123456(3).
+func (hi HKIdCardInfo) String() string {
+	return hi.Prefix + hi.Number + "(" + hi.Check + ")"
+}
+
+// HKIdCard hong kong identity card validator.
+type HKIdCard string
+
+// NewHKIdCard new a hong kong identity card validator.
+func NewHKIdCard(idcard string) HKIdCard {
+	return HKIdCard(idcard)
+}
+
+// IsValid checks the hong kong identity card is valid.
+func (hc HKIdCard) IsValid() bool {
+	_, err := hc.Parse()
+	return err == nil
+}
+
+// Parse parses the hong kong identity card.
+func (hc HKIdCard) Parse() (*HKIdCardInfo, error) {
+	m := hkIdCardRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(string(hc))))
+	if m == nil {
+		return nil, ErrInvalidHKIdCard
+	}
+
+	// the 1 letter prefix is padded with a space which is valued 36
+	chars := m[1] + m[2]
+	if len(m[1]) == 1 {
+		chars = " " + chars
+	}
+	sum := 0
+	for index := 0; index < len(chars); index++ {
+		var value int
+		switch c := chars[index]; {
+		case c == ' ':
+			value = 36
+		case c >= 'A':
+			value = int(c-'A') + 10
+		default:
+			value = int(c - '0')
+		}
+		sum += value * (9 - index)
+	}
+
+	var check uint8
+	switch sum % 11 {
+	case 0:
+		check = '0'
+	case 1:
+		check = 'A'
+	default:
+		check = uint8('0' + 11 - sum%11)
+	}
+	if check != m[3][0] {
+		return nil, ErrInvalidHKIdCard
+	}
+
+	return &HKIdCardInfo{Prefix: m[1], Number: m[2], Check: m[3]}, nil
+}
+
+// MacauIdCardInfo parsed macau identity card information.
+type MacauIdCardInfo struct {
+	Number    string // 7 digits number
+	Check     string // check digit
+	Permanent bool   // whether the holder is a permanent resident, the number starting with 7 is non-permanent
+}
+
+// String returns the macau identity card in the form of 1234567(8).
+func (mi MacauIdCardInfo) String() string {
+	return mi.Number + "(" + mi.Check + ")"
+}
+
+// MacauIdCard macau identity card validator.
+type MacauIdCard string
+
+// NewMacauIdCard new a macau identity card validator.
+func NewMacauIdCard(idcard string) MacauIdCard {
+	return MacauIdCard(idcard)
+}
+
+// IsValid checks the macau identity card is valid, only the format is checked.
+func (mc MacauIdCard) IsValid() bool {
+	_, err := mc.Parse()
+	return err == nil
+}
+
+// Parse parses the macau identity card.
+func (mc MacauIdCard) Parse() (*MacauIdCardInfo, error) {
+	m := macauIdCardRegex.FindStringSubmatch(strings.TrimSpace(string(mc)))
+	if m == nil {
+		return nil, ErrInvalidMacauIdCard
+	}
+
+	return &MacauIdCardInfo{Number: m[1] + m[2], Check: m[3], Permanent: m[1] != "7"}, nil
+}
+
+// TaiwanIdCardInfo parsed taiwan identity card information.
+type TaiwanIdCardInfo struct {
+	County   string // issuing county name, e.g. 台北市
+	Gender   int    // gender, Female or Male
+	Resident bool   // whether it is the uniform id number of the resident certificate issued to foreigners
+}
+
+// TaiwanIdCard taiwan identity card validator.
+type TaiwanIdCard string
+
+// NewTaiwanIdCard new a taiwan identity card validator.
+func NewTaiwanIdCard(idcard string) TaiwanIdCard {
+	return TaiwanIdCard(idcard)
+}
+
+// IsValid checks the taiwan identity card is valid.
+func (tc TaiwanIdCard) IsValid() bool {
+	_, err := tc.Parse()
+	return err == nil
+}
+
+// Parse parses the taiwan identity card, the uniform id number of
+// the resident certificate with gender digit 8 or 9 is also supported.
+func (tc TaiwanIdCard) Parse() (*TaiwanIdCardInfo, error) {
+	tcStr := strings.ToUpper(strings.TrimSpace(string(tc)))
+	if !taiwanIdCardRegex.MatchString(tcStr) {
+		return nil, ErrInvalidTaiwanIdCard
+	}
+
+	value := taiwanLetterValueMap[tcStr[0]]
+	sum := value/10 + value%10*9
+	for index := 1; index < 9; index++ {
+		sum += int(tcStr[index]-'0') * (9 - index)
+	}
+	sum += int(tcStr[9] - '0')
+	if sum%10 != 0 {
+		return nil, ErrInvalidTaiwanIdCard
+	}
+
+	info := &TaiwanIdCardInfo{County: taiwanLetterCountyMap[tcStr[0]], Gender: Female}
+	switch tcStr[1] {
+	case '1':
+		info.Gender = Male
+	case '8':
+		info.Gender = Male
+		info.Resident = true
+	case '9':
+		info.Resident = true
+	}
+
+	return info, nil
+}
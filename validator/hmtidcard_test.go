@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHKIdCard_Parse(t *testing.T) {
+	cases := []struct {
+		idcard string
+		expect string
+	}{
+		{idcard: "A123456(3)", expect: "A123456(3)"},
+		{idcard: "a1234563", expect: "A123456(3)"},
+		{idcard: "AB987654(3)", expect: "AB987654(3)"},
+		{idcard: "Z683365(A)", expect: "Z683365(A)"},
+		{idcard: "A123456(4)", expect: ""},
+		{idcard: "ABC123456(3)", expect: ""},
+		{idcard: "123456(3)", expect: ""},
+		{idcard: "", expect: ""},
+	}
+
+	for _, c := range cases {
+		get := NewHKIdCard(c.idcard)
+		assert.Equal(t, c.expect != "", get.IsValid(), c.idcard)
+		info, err := get.Parse()
+		if c.expect == "" {
+			require.EqualError(t, err, "validator: invalid hong kong idcard")
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, c.expect, info.String())
+	}
+}
+
+func TestMacauIdCard_Parse(t *testing.T) {
+	cases := []struct {
+		idcard          string
+		expect          string
+		expectPermanent bool
+	}{
+		{idcard: "1234567(8)", expect: "1234567(8)", expectPermanent: true},
+		{idcard: "51234568", expect: "5123456(8)", expectPermanent: true},
+		{idcard: "7123456(0)", expect: "7123456(0)", expectPermanent: false},
+		{idcard: "2123456(0)", expect: ""},
+		{idcard: "1234567(A)", expect: ""},
+		{idcard: "", expect: ""},
+	}
+
+	for _, c := range cases {
+		get := NewMacauIdCard(c.idcard)
+		assert.Equal(t, c.expect != "", get.IsValid(), c.idcard)
+		info, err := get.Parse()
+		if c.expect == "" {
+			require.EqualError(t, err, "validator: invalid macau idcard")
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, c.expect, info.String())
+		assert.Equal(t, c.expectPermanent, info.Permanent)
+	}
+}
+
+func TestTaiwanIdCard_Parse(t *testing.T) {
+	cases := []struct {
+		idcard string
+		expect *TaiwanIdCardInfo
+	}{
+		{idcard: "A123456789", expect: &TaiwanIdCardInfo{County: "台北市", Gender: Male}},
+		{idcard: "f223456786", expect: &TaiwanIdCardInfo{County: "新北市", Gender: Female}},
+		{idcard: "A800000014", expect: &TaiwanIdCardInfo{County: "台北市", Gender: Male, Resident: true}},
+		{idcard: "O912345676", expect: &TaiwanIdCardInfo{County: "新竹市", Gender: Female, Resident: true}},
+		{idcard: "A123456788", expect: nil},
+		{idcard: "A323456789", expect: nil},
+		{idcard: "1123456789", expect: nil},
+		{idcard: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewTaiwanIdCard(c.idcard)
+		assert.Equal(t, c.expect != nil, get.IsValid(), c.idcard)
+		info, err := get.Parse()
+		if c.expect != nil {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid taiwan idcard")
+		}
+		assert.Equal(t, c.expect, info, c.idcard)
+	}
+}
@@ -0,0 +1,116 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// international bank account number (IBAN), ISO 13616
+// country code (2 letters) + check digits (2 digits) + basic bank account number (BBAN, up to 30 characters),
+// the length is fixed per country, and the number rearranged with the first 4 characters moved to the end,
+// with the letters replaced by 10 to 35, must be 1 modulo 97
+// registry: https://www.swift.com/standards/data-standards/iban-international-bank-account-number
+
+// ErrInvalidIBAN invalid iban error.
+var ErrInvalidIBAN = errors.New("validator: invalid iban")
+
+var (
+	ibanRegex = regexp.MustCompile(`^([A-Z]{2})([0-9]{2})([A-Z0-9]{11,30})$`)
+
+	// ibanSeparatorReplacer removes the separators of iban.
+	ibanSeparatorReplacer = strings.NewReplacer(" ", "", "-", "")
+
+	// ibanCountryLengthMap country code - iban length map.
+	ibanCountryLengthMap = map[string]int{
+		"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+		"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+		"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+		"GL": 18, "GR": 27, "GT": 28, "HN": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+		"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+		"LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28,
+		"NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22,
+		"RU": 33, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25,
+		"SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+	}
+)
+
+// IBANInfo parsed iban information.
+type IBANInfo struct {
+	Country     string // country code, ISO 3166-1 alpha-2
+	CheckDigits string // 2 check digits
+	BBAN        string // basic bank account number
+}
+
+// String returns the iban in the print format, which is grouped by 4 characters,
+// e.g. GB82 WEST 1234 5698 7654 32.
+func (ii IBANInfo) String() string {
+	s := ii.Country + ii.CheckDigits + ii.BBAN
+
+	var sb strings.Builder
+	for index := 0; index < len(s); index += 4 {
+		if index > 0 {
+			sb.WriteByte(' ')
+		}
+		end := index + 4
+		if end > len(s) {
+			end = len(s)
+		}
+		sb.WriteString(s[index:end])
+	}
+
+	return sb.String()
+}
+
+// IBAN iban validator.
+type IBAN string
+
+// NewIBAN new an iban validator.
+func NewIBAN(iban string) IBAN {
+	return IBAN(iban)
+}
+
+// Normalize normalizes the iban to the electronic format,
+// the separators are removed and the letters are converted to upper case.
+func (i IBAN) Normalize() string {
+	return ibanSeparatorReplacer.Replace(strings.ToUpper(strings.TrimSpace(string(i))))
+}
+
+// IsValid checks the iban is valid.
+func (i IBAN) IsValid() bool {
+	_, err := i.Parse()
+	return err == nil
+}
+
+// Parse parses the iban, the country length and the check digits are checked.
+func (i IBAN) Parse() (*IBANInfo, error) {
+	iStr := i.Normalize()
+	m := ibanRegex.FindStringSubmatch(iStr)
+	if m == nil {
+		return nil, ErrInvalidIBAN
+	}
+	if length, ok := ibanCountryLengthMap[m[1]]; !ok || length != len(iStr) {
+		return nil, ErrInvalidIBAN
+	}
+	if mod97(iStr[4:]+iStr[:4]) != 1 {
+		return nil, ErrInvalidIBAN
+	}
+
+	return &IBANInfo{Country: m[1], CheckDigits: m[2], BBAN: m[3]}, nil
+}
+
+// mod97 computes the alphanumeric string modulo 97, the letters are valued A=10 to Z=35.
+func mod97(s string) int {
+	mod := 0
+	for index := 0; index < len(s); index++ {
+		c := s[index]
+		if c >= 'A' {
+			value := int(c-'A') + 10
+			mod = (mod*100 + value) % 97
+		} else {
+			mod = (mod*10 + int(c-'0')) % 97
+		}
+	}
+
+	return mod
+}
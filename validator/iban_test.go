@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBAN_Parse(t *testing.T) {
+	cases := []struct {
+		iban   string
+		expect *IBANInfo
+	}{
+		{iban: "GB82WEST12345698765432", expect: &IBANInfo{Country: "GB", CheckDigits: "82", BBAN: "WEST12345698765432"}},
+		{iban: "de89 3704 0044 0532 0130 00", expect: &IBANInfo{Country: "DE", CheckDigits: "89", BBAN: "370400440532013000"}},
+		{iban: "NO9386011117947", expect: &IBANInfo{Country: "NO", CheckDigits: "93", BBAN: "86011117947"}},
+		{iban: "RU0304452522540817810538091310419", expect: &IBANInfo{Country: "RU", CheckDigits: "03", BBAN: "04452522540817810538091310419"}},
+		{iban: "OM810180000001299123456", expect: &IBANInfo{Country: "OM", CheckDigits: "81", BBAN: "0180000001299123456"}},
+		{iban: "NI79BAMC00000000000003123123", expect: &IBANInfo{Country: "NI", CheckDigits: "79", BBAN: "BAMC00000000000003123123"}},
+		{iban: "GB82WEST12345698765433", expect: nil},
+		{iban: "GB82WEST1234569876543", expect: nil},
+		{iban: "CN82WEST12345698765432", expect: nil},
+		{iban: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewIBAN(c.iban)
+		assert.Equal(t, c.expect != nil, get.IsValid(), c.iban)
+		info, err := get.Parse()
+		if c.expect != nil {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid iban")
+		}
+		assert.Equal(t, c.expect, info, c.iban)
+	}
+
+	info, err := NewIBAN("GB82WEST12345698765432").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "GB82 WEST 1234 5698 7654 32", info.String())
+	assert.Equal(t, "GB82WEST12345698765432", NewIBAN(" gb82-west-1234-5698-7654-32 ").Normalize())
+}
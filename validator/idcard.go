@@ -52,6 +52,22 @@ var modCheckCodeMap = map[int]uint8{
 	10: '2',
 }
 
+// ErrInvalidIdCard invalid id card error.
+var ErrInvalidIdCard = errors.New("validator: invalid idcard")
+
+// chinese zodiac animals, starting from the rat year.
+var zodiacs = [12]string{"鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊", "猴", "鸡", "狗", "猪"}
+
+// constellations, starting from capricorn,
+// constellationStartDays represents the start day of the next constellation in each month.
+var (
+	constellations = [13]string{
+		"摩羯座", "水瓶座", "双鱼座", "白羊座", "金牛座", "双子座",
+		"巨蟹座", "狮子座", "处女座", "天秤座", "天蝎座", "射手座", "摩羯座",
+	}
+	constellationStartDays = [12]int{20, 19, 21, 20, 21, 22, 23, 23, 23, 24, 23, 22}
+)
+
 // IdCard id card validator.
 type IdCard string
 
@@ -60,54 +76,55 @@ func NewIdCard(idcard string) IdCard {
 	return IdCard(idcard)
 }
 
-// IsValid checks the id card is valid.
+// IsValid checks the id card is valid, both the 18 digits id card
+// and the 15 digits id card issued before 1999 are supported.
 func (ic IdCard) IsValid() bool {
 	icStr := strings.ToUpper(string(ic))
+	if len(icStr) == 15 {
+		return isValidIdCard15(icStr)
+	}
 	if !idcardRegex.MatchString(icStr) {
 		return false
 	}
 
-	sum := 0
-	checkCode := icStr[17]
+	return checkCode(icStr[:17]) == icStr[17]
+}
 
-	for index := range icStr[:17] {
-		a, err := strconv.Atoi(string(icStr[index]))
-		if err != nil {
-			return false
-		}
-		// 计算加权因子
-		w := idCardIndexWeightMap[index]
-		// 计算加权和
-		sum += a * w
+// Mask returns the masked id card for display, which only keeps the first 3 and the last 4 characters,
+// e.g. 110***********0019.
+func (ic IdCard) Mask() string {
+	return MaskKeep(string(ic), 3, 4)
+}
+
+// To18 converts the 15 digits id card to the 18 digits id card,
+// the 18 digits id card is returned as it is.
+func (ic IdCard) To18() (IdCard, error) {
+	if !ic.IsValid() {
+		return "", ErrInvalidIdCard
 	}
 
-	return modCheckCodeMap[sum%11] == checkCode
+	icStr := strings.ToUpper(string(ic))
+	if len(icStr) == 18 {
+		return IdCard(icStr), nil
+	}
+
+	icStr = icStr[:6] + "19" + icStr[6:]
+
+	return IdCard(icStr + string(checkCode(icStr))), nil
 }
 
 // GetBirthday gets the birthday recorded on id card.
 func (ic IdCard) GetBirthday() (time.Time, error) {
 	if !ic.IsValid() {
-		return time.Time{}, errors.New("validator: invalid idcard")
+		return time.Time{}, ErrInvalidIdCard
 	}
 
 	icStr := string(ic)
-	yearStr, monthStr, dayStr := icStr[6:10], icStr[10:12], icStr[12:14]
-	year, err := strconv.Atoi(yearStr)
-	if err != nil {
-		return time.Time{}, err
+	if len(icStr) == 15 {
+		return parseBirthday("19" + icStr[6:12])
 	}
 
-	month, err := strconv.Atoi(monthStr)
-	if err != nil {
-		return time.Time{}, err
-	}
-
-	day, err := strconv.Atoi(dayStr)
-	if err != nil {
-		return time.Time{}, err
-	}
-
-	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, timex.Shanghai()), nil
+	return parseBirthday(icStr[6:14])
 }
 
 const (
@@ -120,11 +137,16 @@ const (
 // GetGender gets the gender recorded on id card.
 func (ic IdCard) GetGender() (int, error) {
 	if !ic.IsValid() {
-		return 0, errors.New("validator: invalid idcard")
+		return 0, ErrInvalidIdCard
 	}
 
 	icStr := string(ic)
-	numStr := icStr[14:17]
+	var numStr string
+	if len(icStr) == 15 {
+		numStr = icStr[12:15]
+	} else {
+		numStr = icStr[14:17]
+	}
 	num, err := strconv.Atoi(numStr)
 	if err != nil {
 		return 0, err
@@ -152,3 +174,111 @@ func (ic IdCard) IsFemale() (bool, error) {
 
 	return gender == Female, nil
 }
+
+// Region gets the region of the household registration recorded on id card,
+// the historical division codes are also resolved.
+func (ic IdCard) Region() (*Region, error) {
+	if !ic.IsValid() {
+		return nil, ErrInvalidIdCard
+	}
+
+	return LookupRegion(string(ic)[:6])
+}
+
+// Age gets the age at the time by the birthday recorded on id card,
+// it returns 0 if the time is before the birthday.
+func (ic IdCard) Age(at time.Time) (int, error) {
+	birthday, err := ic.GetBirthday()
+	if err != nil {
+		return 0, err
+	}
+
+	at = at.In(birthday.Location())
+	age := at.Year() - birthday.Year()
+	if at.Month() < birthday.Month() || (at.Month() == birthday.Month() && at.Day() < birthday.Day()) {
+		age--
+	}
+	if age < 0 {
+		age = 0
+	}
+
+	return age, nil
+}
+
+// Zodiac gets the chinese zodiac by the birth year recorded on id card,
+// e.g. 马, the lunar new year is not taken into account.
+func (ic IdCard) Zodiac() (string, error) {
+	birthday, err := ic.GetBirthday()
+	if err != nil {
+		return "", err
+	}
+
+	return zodiacs[((birthday.Year()-4)%12+12)%12], nil
+}
+
+// Constellation gets the constellation by the birthday recorded on id card, e.g. 摩羯座.
+func (ic IdCard) Constellation() (string, error) {
+	birthday, err := ic.GetBirthday()
+	if err != nil {
+		return "", err
+	}
+
+	month := int(birthday.Month())
+	if birthday.Day() < constellationStartDays[month-1] {
+		return constellations[month-1], nil
+	}
+
+	return constellations[month], nil
+}
+
+// checkCode computes the check code of the first 17 digits of id card.
+func checkCode(icStr string) uint8 {
+	sum := 0
+	for index := range icStr {
+		a := int(icStr[index] - '0')
+		// 计算加权因子
+		w := idCardIndexWeightMap[index]
+		// 计算加权和
+		sum += a * w
+	}
+
+	return modCheckCodeMap[sum%11]
+}
+
+// isValidIdCard15 checks the 15 digits id card, which has no check code,
+// so that the birthday and the region are checked instead, the region is resolved by LookupRegion,
+// and only its province is checked if the historical prefecture is absent from the table.
+func isValidIdCard15(icStr string) bool {
+	if !idcard15Regex.MatchString(icStr) {
+		return false
+	}
+	if _, err := LookupRegion(icStr[:6]); err != nil {
+		if _, err = LookupRegion(icStr[:2] + "0000"); err != nil {
+			return false
+		}
+	}
+
+	birthday, err := parseBirthday("19" + icStr[6:12])
+
+	return err == nil && birthday.Format("20060102") == "19"+icStr[6:12]
+}
+
+// parseBirthday parses the birthday in the form of 20060102.
+func parseBirthday(s string) (time.Time, error) {
+	year, err := strconv.Atoi(s[:4])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	month, err := strconv.Atoi(s[4:6])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	day, err := strconv.Atoi(s[6:8])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, timex.Shanghai()), nil
+}
@@ -2,9 +2,12 @@ package validator
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/sliveryou/go-tool/v2/timex"
 )
 
 func TestIdCard_IsValid(t *testing.T) {
@@ -114,3 +117,148 @@ func TestIdCard_IsFemale(t *testing.T) {
 		}
 	}
 }
+
+func TestIdCard_Region(t *testing.T) {
+	cases := []struct {
+		idcard       string
+		expectRegion string
+		expectCity   string
+		expectErr    error
+	}{
+		{idcard: "110101199003071233", expectRegion: "北京市东城区", expectCity: "市辖区"},
+		{idcard: "51020219851231002X", expectRegion: "四川省重庆市市中区", expectCity: "重庆市"},
+		{idcard: "522401200002290047", expectRegion: "贵州省毕节地区毕节市", expectCity: "毕节地区"},
+		{idcard: "330106197211200056", expectRegion: "浙江省杭州市西湖区", expectCity: "杭州市"},
+		{idcard: "440305202401010011", expectRegion: "广东省深圳市南山区", expectCity: "深圳市"},
+		{idcard: "510107199003070014", expectRegion: "四川省成都市武侯区", expectCity: "成都市"},
+		// the county absent from the table is resolved to its prefecture
+		{idcard: "32058319900105331X", expectRegion: "江苏省苏州市", expectCity: "苏州市"},
+		{idcard: "119901199001053316", expectErr: ErrInvalidRegion},
+		{idcard: "330333200801052846", expectErr: ErrInvalidIdCard},
+	}
+
+	for _, c := range cases {
+		get := NewIdCard(c.idcard)
+		region, err := get.Region()
+		if c.expectErr != nil {
+			require.ErrorIs(t, err, c.expectErr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, c.idcard[:6], region.Code)
+		assert.Equal(t, c.expectCity, region.City)
+		assert.Equal(t, c.expectRegion, region.String())
+	}
+}
+
+func TestIdCard_Age(t *testing.T) {
+	ic := NewIdCard("110101199003071233")
+	cases := []struct {
+		at     time.Time
+		expect int
+	}{
+		{at: time.Date(2026, 3, 6, 23, 0, 0, 0, timex.Shanghai()), expect: 35},
+		{at: time.Date(2026, 3, 7, 0, 0, 0, 0, timex.Shanghai()), expect: 36},
+		{at: time.Date(2026, 3, 6, 16, 0, 0, 0, time.UTC), expect: 36},
+		{at: time.Date(1989, 3, 7, 0, 0, 0, 0, timex.Shanghai()), expect: 0},
+	}
+
+	for _, c := range cases {
+		age, err := ic.Age(c.at)
+		require.NoError(t, err)
+		assert.Equal(t, c.expect, age, c.at)
+	}
+
+	_, err := NewIdCard("").Age(time.Now())
+	require.ErrorIs(t, err, ErrInvalidIdCard)
+}
+
+func TestIdCard_ZodiacConstellation(t *testing.T) {
+	cases := []struct {
+		idcard              string
+		expectZodiac        string
+		expectConstellation string
+	}{
+		{idcard: "110101199003071233", expectZodiac: "马", expectConstellation: "双鱼座"},
+		{idcard: "51020219851231002X", expectZodiac: "牛", expectConstellation: "摩羯座"},
+		{idcard: "522401200002290047", expectZodiac: "龙", expectConstellation: "双鱼座"},
+		{idcard: "330106197211200056", expectZodiac: "鼠", expectConstellation: "天蝎座"},
+		{idcard: "440305202401010011", expectZodiac: "龙", expectConstellation: "摩羯座"},
+	}
+
+	for _, c := range cases {
+		get := NewIdCard(c.idcard)
+		zodiac, err := get.Zodiac()
+		require.NoError(t, err)
+		assert.Equal(t, c.expectZodiac, zodiac)
+		constellation, err := get.Constellation()
+		require.NoError(t, err)
+		assert.Equal(t, c.expectConstellation, constellation)
+	}
+
+	_, err := NewIdCard("").Zodiac()
+	require.ErrorIs(t, err, ErrInvalidIdCard)
+	_, err = NewIdCard("").Constellation()
+	require.ErrorIs(t, err, ErrInvalidIdCard)
+}
+
+func TestIdCard_15(t *testing.T) {
+	cases := []struct {
+		idcard         string
+		expect         bool
+		expect18       IdCard
+		expectBirthday string
+		expectGender   int
+	}{
+		{idcard: "110101900307123", expect: true, expect18: "110101199003071233", expectBirthday: "1990-03-07", expectGender: Male},
+		{idcard: "330106721120002", expect: true, expect18: "330106197211200021", expectBirthday: "1972-11-20", expectGender: Female},
+		{idcard: "110101900230123", expect: false},
+		{idcard: "510202851231002", expect: true, expect18: "51020219851231002X", expectBirthday: "1985-12-31", expectGender: Female},
+		{idcard: "990101900307123", expect: false},
+		{idcard: "320583900307123", expect: true, expect18: "320583199003071239", expectBirthday: "1990-03-07", expectGender: Male},
+		{idcard: "510107900307001", expect: true, expect18: "510107199003070014", expectBirthday: "1990-03-07", expectGender: Male},
+		// only the province is checked if the prefecture is absent from the table
+		{idcard: "512901900307123", expect: true, expect18: "512901199003071233", expectBirthday: "1990-03-07", expectGender: Male},
+		{idcard: "999901900307123", expect: false},
+		{idcard: "009901900307123", expect: false},
+		{idcard: "11010190030712", expect: false},
+		{idcard: "11010190030712X", expect: false},
+	}
+
+	for _, c := range cases {
+		get := NewIdCard(c.idcard)
+		assert.Equal(t, c.expect, get.IsValid(), c.idcard)
+
+		ic18, err := get.To18()
+		if !c.expect {
+			require.ErrorIs(t, err, ErrInvalidIdCard)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, c.expect18, ic18)
+		assert.True(t, ic18.IsValid())
+
+		birthday, err := get.GetBirthday()
+		require.NoError(t, err)
+		assert.Equal(t, c.expectBirthday, birthday.Format("2006-01-02"))
+		gender, err := get.GetGender()
+		require.NoError(t, err)
+		assert.Equal(t, c.expectGender, gender)
+
+		gender18, err := ic18.GetGender()
+		require.NoError(t, err)
+		assert.Equal(t, gender, gender18)
+	}
+
+	ic18, err := NewIdCard("51020219851231002x").To18()
+	require.NoError(t, err)
+	assert.Equal(t, IdCard("51020219851231002X"), ic18)
+
+	region, err := NewIdCard("510202851231002").Region()
+	require.NoError(t, err)
+	assert.Equal(t, "四川省重庆市市中区", region.String())
+	_, err = NewIdCard("999901900307123").Region()
+	require.ErrorIs(t, err, ErrInvalidIdCard)
+	_, err = NewIdCard("512901900307123").Region()
+	require.ErrorIs(t, err, ErrInvalidRegion)
+}
@@ -0,0 +1,139 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// international standard book number (ISBN), ISO 2108
+// ISBN-10: 9 digits + check digit, the weighted sum of the 10 digits with weights 10 to 1 must be divisible by 11,
+// and 10 is represented by X
+// ISBN-13: 978 or 979 + 9 digits + check digit, which is the gs1 check digit
+// international standard serial number (ISSN), ISO 3297
+// 7 digits + check digit, the weighted sum of the 8 digits with weights 8 to 1 must be divisible by 11,
+// and 10 is represented by X, the issn is written as 2 groups of 4 characters, e.g. 0317-8471
+
+var (
+	// ErrInvalidISBN invalid isbn error.
+	ErrInvalidISBN = errors.New("validator: invalid isbn")
+	// ErrInvalidISSN invalid issn error.
+	ErrInvalidISSN = errors.New("validator: invalid issn")
+
+	isbn10Regex = regexp.MustCompile(`^[0-9]{9}[0-9X]$`)
+	isbn13Regex = regexp.MustCompile(`^97[89][0-9]{10}$`)
+	issnRegex   = regexp.MustCompile(`^[0-9]{7}[0-9X]$`)
+
+	// isbnSeparatorReplacer removes the separators of isbn and issn.
+	isbnSeparatorReplacer = strings.NewReplacer(" ", "", "-", "")
+)
+
+// ISBN isbn validator.
+type ISBN string
+
+// NewISBN new an isbn validator.
+func NewISBN(isbn string) ISBN {
+	return ISBN(isbn)
+}
+
+// Normalize normalizes the isbn, the separators are removed and x is converted to X.
+func (i ISBN) Normalize() string {
+	return isbnSeparatorReplacer.Replace(strings.ToUpper(strings.TrimSpace(string(i))))
+}
+
+// IsValid checks the isbn is valid, both ISBN-10 and ISBN-13 are supported.
+func (i ISBN) IsValid() bool {
+	return i.IsISBN10() || i.IsISBN13()
+}
+
+// IsISBN10 checks the isbn is a valid ISBN-10.
+func (i ISBN) IsISBN10() bool {
+	iStr := i.Normalize()
+	if !isbn10Regex.MatchString(iStr) {
+		return false
+	}
+
+	return mod11CheckDigit(iStr[:9]) == iStr[9]
+}
+
+// IsISBN13 checks the isbn is a valid ISBN-13.
+func (i ISBN) IsISBN13() bool {
+	iStr := i.Normalize()
+	if !isbn13Regex.MatchString(iStr) {
+		return false
+	}
+
+	return gs1CheckDigit(iStr[:12]) == iStr[12]
+}
+
+// To10 converts the isbn to ISBN-10, only the ISBN-13 with prefix 978 can be converted.
+func (i ISBN) To10() (ISBN, error) {
+	iStr := i.Normalize()
+	switch {
+	case i.IsISBN10():
+		return ISBN(iStr), nil
+	case i.IsISBN13() && strings.HasPrefix(iStr, "978"):
+		return ISBN(iStr[3:12] + string(mod11CheckDigit(iStr[3:12]))), nil
+	default:
+		return "", ErrInvalidISBN
+	}
+}
+
+// To13 converts the isbn to ISBN-13 with prefix 978.
+func (i ISBN) To13() (ISBN, error) {
+	iStr := i.Normalize()
+	switch {
+	case i.IsISBN13():
+		return ISBN(iStr), nil
+	case i.IsISBN10():
+		iStr = "978" + iStr[:9]
+		return ISBN(iStr + string(gs1CheckDigit(iStr))), nil
+	default:
+		return "", ErrInvalidISBN
+	}
+}
+
+// ISSN issn validator.
+type ISSN string
+
+// NewISSN new an issn validator.
+func NewISSN(issn string) ISSN {
+	return ISSN(issn)
+}
+
+// IsValid checks the issn is valid.
+func (i ISSN) IsValid() bool {
+	iStr := isbnSeparatorReplacer.Replace(strings.ToUpper(strings.TrimSpace(string(i))))
+	if !issnRegex.MatchString(iStr) {
+		return false
+	}
+
+	return mod11CheckDigit(iStr[:7]) == iStr[7]
+}
+
+// ToEAN13 converts the issn to the EAN-13 barcode with prefix 977 and price code 00.
+func (i ISSN) ToEAN13() (string, error) {
+	if !i.IsValid() {
+		return "", ErrInvalidISSN
+	}
+
+	iStr := "977" + isbnSeparatorReplacer.Replace(strings.TrimSpace(string(i)))[:7] + "00"
+
+	return iStr + string(gs1CheckDigit(iStr)), nil
+}
+
+// mod11CheckDigit computes the modulo 11 check digit of isbn and issn,
+// the weights are from len(s) + 1 down to 2, and 10 is represented by X.
+func mod11CheckDigit(s string) uint8 {
+	sum := 0
+	for index := 0; index < len(s); index++ {
+		sum += int(s[index]-'0') * (len(s) + 1 - index)
+	}
+
+	switch check := (11 - sum%11) % 11; check {
+	case 10:
+		return 'X'
+	default:
+		return uint8('0' + check)
+	}
+}
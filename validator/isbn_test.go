@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestISBN_IsValid(t *testing.T) {
+	cases := []struct {
+		isbn     string
+		expect10 bool
+		expect13 bool
+	}{
+		{isbn: "0306406152", expect10: true},
+		{isbn: "0-306-40615-2", expect10: true},
+		{isbn: "080442957x", expect10: true},
+		{isbn: "978-0-306-40615-7", expect13: true},
+		{isbn: "9791090636071", expect13: true},
+		{isbn: "0306406153"},
+		{isbn: "9780306406158"},
+		{isbn: "9770317847001"},
+		{isbn: ""},
+	}
+
+	for _, c := range cases {
+		get := NewISBN(c.isbn)
+		assert.Equal(t, c.expect10 || c.expect13, get.IsValid(), c.isbn)
+		assert.Equal(t, c.expect10, get.IsISBN10(), c.isbn)
+		assert.Equal(t, c.expect13, get.IsISBN13(), c.isbn)
+	}
+}
+
+func TestISBN_Convert(t *testing.T) {
+	isbn, err := NewISBN("0-306-40615-2").To13()
+	require.NoError(t, err)
+	assert.Equal(t, ISBN("9780306406157"), isbn)
+	isbn, err = isbn.To10()
+	require.NoError(t, err)
+	assert.Equal(t, ISBN("0306406152"), isbn)
+
+	isbn, err = NewISBN("978-0-8044-2957-3").To10()
+	require.NoError(t, err)
+	assert.Equal(t, ISBN("080442957X"), isbn)
+
+	_, err = NewISBN("9791090636071").To10()
+	require.EqualError(t, err, "validator: invalid isbn")
+	_, err = NewISBN("0306406153").To13()
+	require.EqualError(t, err, "validator: invalid isbn")
+}
+
+func TestISSN(t *testing.T) {
+	assert.True(t, NewISSN("0317-8471").IsValid())
+	assert.True(t, NewISSN("2049-3630").IsValid())
+	assert.True(t, NewISSN("1000-002x").IsValid())
+	assert.False(t, NewISSN("0317-8472").IsValid())
+	assert.False(t, NewISSN("0317847").IsValid())
+
+	ean, err := NewISSN("0317-8471").ToEAN13()
+	require.NoError(t, err)
+	assert.Equal(t, "9770317847001", ean)
+	assert.True(t, NewGTIN(ean).IsValid())
+
+	_, err = NewISSN("0317-8472").ToEAN13()
+	require.EqualError(t, err, "validator: invalid issn")
+}
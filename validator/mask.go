@@ -0,0 +1,358 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// tagMask tag of the mask rules, e.g. `mask:"idcard"`, `mask:"keep=3,4"`.
+const tagMask = "mask"
+
+// built-in mask rules.
+const (
+	MaskRuleAll         = "all"         // masks all characters
+	MaskRuleKeep        = "keep"        // keeps the first and the last N characters, e.g. keep=3,4
+	MaskRuleName        = "name"        // chinese name, e.g. 张*三
+	MaskRuleEmail       = "email"       // local part of the email, e.g. z******n@example.com
+	MaskRuleMobile      = "mobile"      // e.g. 138****5678
+	MaskRuleIdCard      = "idcard"      // e.g. 110***********0019
+	MaskRuleBankCard    = "bankcard"    // e.g. 6222 **** **** 4273
+	MaskRuleCorpAccount = "corpaccount" // e.g. 1234*************5678
+	MaskRuleAddress     = "address"     // keeps the administrative divisions, e.g. 浙江省杭州市西湖区*******
+)
+
+// MaskFunc masks the sensitive string.
+type MaskFunc func(s string) string
+
+var (
+	// maskMutex mask mutex, used to ensure concurrency security of registering mask rules.
+	maskMutex sync.RWMutex
+	// maskFuncMap rule name - mask function map.
+	maskFuncMap = map[string]MaskFunc{
+		MaskRuleAll:         func(s string) string { return strings.Repeat("*", utf8.RuneCountInString(s)) },
+		MaskRuleName:        MaskName,
+		MaskRuleEmail:       MaskEmail,
+		MaskRuleMobile:      func(s string) string { return NewMobile(s).Mask() },
+		MaskRuleIdCard:      func(s string) string { return NewIdCard(s).Mask() },
+		MaskRuleBankCard:    func(s string) string { return NewBankCard(s).Mask() },
+		MaskRuleCorpAccount: func(s string) string { return NewCorpAccount(s).Mask() },
+		MaskRuleAddress:     MaskAddress,
+	}
+
+	// addressDivisionSuffixes suffixes of the administrative divisions kept by MaskAddress.
+	addressDivisionSuffixes = "省市区县旗州盟"
+)
+
+// RegisterMaskRule registers the mask function of the rule, which can be used by the mask tag,
+// the built-in rules except keep can be overridden.
+func RegisterMaskRule(rule string, fn MaskFunc) {
+	maskMutex.Lock()
+	defer maskMutex.Unlock()
+
+	maskFuncMap[rule] = fn
+}
+
+// Mask masks the string by the rule, e.g. idcard, keep=3,4,
+// all characters are masked if the rule is unknown, so the sensitive string never leaks.
+func Mask(s, rule string) string {
+	if s == "" {
+		return s
+	}
+
+	name, param, _ := strings.Cut(rule, "=")
+	if name == MaskRuleKeep {
+		firstStr, lastStr, _ := strings.Cut(param, ",")
+		first, err1 := strconv.Atoi(strings.TrimSpace(firstStr))
+		last, err2 := strconv.Atoi(strings.TrimSpace(lastStr))
+		if err1 == nil && err2 == nil {
+			return MaskKeep(s, first, last)
+		}
+		name = MaskRuleAll
+	}
+
+	maskMutex.RLock()
+	fn, ok := maskFuncMap[name]
+	if !ok {
+		fn = maskFuncMap[MaskRuleAll]
+	}
+	maskMutex.RUnlock()
+
+	return fn(s)
+}
+
+// MaskKeep keeps the first and the last N characters and masks the others by *,
+// all characters are masked if the string is not longer than first + last.
+func MaskKeep(s string, first, last int) string {
+	runes := []rune(s)
+	if first < 0 || last < 0 || len(runes) <= first+last {
+		return strings.Repeat("*", len(runes))
+	}
+
+	return string(runes[:first]) + strings.Repeat("*", len(runes)-first-last) + string(runes[len(runes)-last:])
+}
+
+// MaskName masks the chinese name, which keeps the first and the last characters, e.g. 张*三, 欧**锋,
+// the name with two characters only keeps the first one, e.g. 张*.
+func MaskName(name string) string {
+	runes := []rune(strings.TrimSpace(name))
+	switch len(runes) {
+	case 0:
+		return ""
+	case 1:
+		return "*"
+	case 2:
+		return string(runes[0]) + "*"
+	default:
+		return MaskKeep(string(runes), 1, 1)
+	}
+}
+
+// MaskEmail masks the local part of the email like MaskName, e.g. z******n@example.com,
+// the string without @ is masked as the local part.
+func MaskEmail(email string) string {
+	if i := strings.LastIndexByte(email, '@'); i >= 0 {
+		return MaskName(email[:i]) + email[i:]
+	}
+
+	return MaskName(email)
+}
+
+// MaskAddress masks the address after the administrative divisions like 省, 市, 区 and 县,
+// e.g. 浙江省杭州市西湖区*******, at least a third of the characters are masked.
+func MaskAddress(address string) string {
+	runes := []rune(address)
+	keep := 0
+	for i := 0; i < len(runes)-(len(runes)+2)/3; i++ {
+		if strings.ContainsRune(addressDivisionSuffixes, runes[i]) {
+			keep = i + 1
+		}
+	}
+	if keep == 0 {
+		keep = len(runes) / 2
+	}
+
+	return MaskKeep(address, keep, 0)
+}
+
+// MaskStruct returns the masked deep copy of the value, the string fields with the mask tag
+// are masked by the rules, e.g. `mask:"idcard"`, and the value itself is not modified.
+// The nested structs, pointers, slices, arrays, maps and interfaces are copied recursively,
+// the mask tag of the string slice or map field applies to each string element,
+// and the cyclic pointers are copied as the same cycles.
+// The unexported fields can not be masked, so they are shallow copied, but all unexported fields
+// of the struct are zeroed if any of them has the mask tag or contains the fields with the mask tag.
+func MaskStruct[T any](v T) T {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return v
+	}
+
+	return maskValue(rv, "", make(map[maskVisit]reflect.Value)).Interface().(T)
+}
+
+// maskVisit visited pointer of masking, the same pointer masked by the same rule shares the copy.
+type maskVisit struct {
+	ptr  uintptr
+	typ  reflect.Type
+	rule string
+}
+
+// maskValue returns the masked deep copy of the value, the strings are masked by the rule if it is not empty,
+// the copies of the visited pointers are reused to handle the cyclic pointers.
+func maskValue(rv reflect.Value, rule string, visited map[maskVisit]reflect.Value) reflect.Value {
+	switch rv.Kind() {
+	case reflect.String:
+		if rule == "" || rule == "-" {
+			return rv
+		}
+		dst := reflect.New(rv.Type()).Elem()
+		dst.SetString(Mask(rv.String(), rule))
+		return dst
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return rv
+		}
+		key := maskVisit{ptr: rv.Pointer(), typ: rv.Type(), rule: rule}
+		if dst, ok := visited[key]; ok {
+			return dst
+		}
+		dst := reflect.New(rv.Type().Elem())
+		visited[key] = dst
+		dst.Elem().Set(maskValue(rv.Elem(), rule, visited))
+		return dst
+	case reflect.Interface:
+		if rv.IsNil() {
+			return rv
+		}
+		dst := reflect.New(rv.Type()).Elem()
+		dst.Set(maskValue(rv.Elem(), rule, visited))
+		return dst
+	case reflect.Struct:
+		dst := reflect.New(rv.Type()).Elem()
+		if !hasSensitiveUnexported(rv) {
+			dst.Set(rv)
+		}
+		maskFields(dst, rv, visited)
+		return dst
+	case reflect.Slice:
+		if rv.IsNil() {
+			return rv
+		}
+		dst := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			dst.Index(i).Set(maskValue(rv.Index(i), rule, visited))
+		}
+		return dst
+	case reflect.Array:
+		dst := reflect.New(rv.Type()).Elem()
+		for i := 0; i < rv.Len(); i++ {
+			dst.Index(i).Set(maskValue(rv.Index(i), rule, visited))
+		}
+		return dst
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+		dst := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), maskValue(iter.Value(), rule, visited))
+		}
+		return dst
+	default:
+		return rv
+	}
+}
+
+// maskFields sets the masked settable fields of the src struct to the dst struct,
+// the exported fields promoted by the embedded unexported struct are also settable.
+func maskFields(dst, src reflect.Value, visited map[maskVisit]reflect.Value) {
+	rt := src.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if fv := dst.Field(i); fv.CanSet() {
+			fv.Set(maskValue(src.Field(i), sf.Tag.Get(tagMask), visited))
+		} else if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			maskFields(fv, src.Field(i), visited)
+		}
+	}
+}
+
+// hasSensitiveUnexported reports whether any unexported field of the struct has the mask tag
+// or contains the fields with the mask tag, the interface field is checked by its dynamic type,
+// and only the unexported fields of the embedded unexported struct are checked.
+func hasSensitiveUnexported(rv reflect.Value) bool {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath == "" {
+			continue
+		}
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			if hasSensitiveUnexported(rv.Field(i)) {
+				return true
+			}
+			continue
+		}
+		if rule := sf.Tag.Get(tagMask); rule != "" && rule != "-" {
+			return true
+		}
+		typ := sf.Type
+		if fv := rv.Field(i); fv.Kind() == reflect.Interface && !fv.IsNil() {
+			typ = fv.Elem().Type()
+		}
+		if hasMaskTag(typ, make(map[reflect.Type]bool)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasMaskTag reports whether the type contains the fields with the mask tag.
+func hasMaskTag(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
+		return false
+	}
+	visited[typ] = true
+
+	switch typ.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return hasMaskTag(typ.Elem(), visited)
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			sf := typ.Field(i)
+			if rule := sf.Tag.Get(tagMask); (rule != "" && rule != "-") || hasMaskTag(sf.Type, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Sensitive wraps the sensitive value for logging, which formats, stringifies and marshals
+// the masked deep copy of the value, so the sensitive fields never reach the logs in clear text.
+// It also implements slog.LogValuer when built with go1.21 or later.
+type Sensitive struct {
+	v    any
+	rule string
+}
+
+// NewSensitive new a sensitive value, the optional rule masks the value itself if it is a string,
+// e.g. NewSensitive("13812345678", MaskRuleMobile), the struct fields are masked by the mask tags.
+func NewSensitive(v any, rule ...string) Sensitive {
+	s := Sensitive{v: v}
+	if len(rule) > 0 {
+		s.rule = rule[0]
+	}
+
+	return s
+}
+
+// Value returns the masked deep copy of the value.
+func (s Sensitive) Value() any {
+	rv := reflect.ValueOf(s.v)
+	if !rv.IsValid() {
+		return nil
+	}
+
+	return maskValue(rv, s.rule, make(map[maskVisit]reflect.Value)).Interface()
+}
+
+// String returns the masked value formatted by %v and implements the fmt.Stringer interface.
+func (s Sensitive) String() string {
+	return fmt.Sprint(s.Value())
+}
+
+// Format formats the masked value by the verb and the flags, e.g. %+v, %#v, %q,
+// and implements the fmt.Formatter interface.
+func (s Sensitive) Format(f fmt.State, verb rune) {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		b.WriteString(strconv.Itoa(width))
+	}
+	if prec, ok := f.Precision(); ok {
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(prec))
+	}
+	b.WriteRune(verb)
+
+	fmt.Fprintf(f, b.String(), s.Value())
+}
+
+// MarshalJSON marshals the masked value and implements the json.Marshaler interface.
+func (s Sensitive) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Value())
+}
@@ -0,0 +1,10 @@
+//go:build go1.21
+
+package validator
+
+import "log/slog"
+
+// LogValue returns the masked value and implements the slog.LogValuer interface.
+func (s Sensitive) LogValue() slog.Value {
+	return slog.AnyValue(s.Value())
+}
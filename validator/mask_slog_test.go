@@ -0,0 +1,24 @@
+//go:build go1.21
+
+package validator
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSensitive_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logger.Info("login", "customer", NewSensitive(_Customer{Name: "张小三", IdCard: "110101199003070019"}),
+		"mobile", NewSensitive("13812345678", MaskRuleMobile))
+	assert.Contains(t, buf.String(), `"idcard":"110***********0019"`)
+	assert.Contains(t, buf.String(), `"name":"张*三"`)
+	assert.Contains(t, buf.String(), `"mobile":"138****5678"`)
+	assert.NotContains(t, buf.String(), "110101199003070019")
+	assert.NotContains(t, buf.String(), "13812345678")
+}
@@ -0,0 +1,204 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _Customer struct {
+	Name     string            `json:"name" mask:"name"`
+	IdCard   IdCard            `json:"idcard" mask:"idcard"`
+	Mobile   *string           `json:"mobile" mask:"mobile"`
+	Email    string            `json:"email" mask:"email"`
+	Address  string            `json:"address" mask:"address"`
+	Cards    []string          `json:"cards" mask:"bankcard"`
+	Accounts map[string]string `json:"accounts" mask:"corpaccount"`
+	Token    string            `json:"token" mask:"keep=2,2"`
+	Remark   string            `json:"remark" mask:"unknown"`
+	Level    int               `json:"level" mask:"all"`
+	Contact  *_Customer        `json:"contact"`
+	Extra    any               `json:"extra"`
+	note     string
+}
+
+type _Account struct {
+	User     string `mask:"name"`
+	password string `mask:"all"`
+	owner    *_Customer
+	level    int
+}
+
+type _Node struct {
+	Name string `mask:"name"`
+	Next *_Node
+}
+
+func TestMask(t *testing.T) {
+	cases := []struct {
+		s      string
+		rule   string
+		expect string
+	}{
+		{s: "张三", rule: MaskRuleName, expect: "张*"},
+		{s: "张小三", rule: MaskRuleName, expect: "张*三"},
+		{s: "欧阳娜娜", rule: MaskRuleName, expect: "欧**娜"},
+		{s: "张", rule: MaskRuleName, expect: "*"},
+		{s: "zhangsan@example.com", rule: MaskRuleEmail, expect: "z******n@example.com"},
+		{s: "zs@example.com", rule: MaskRuleEmail, expect: "z*@example.com"},
+		{s: "+86 138-1234-5678", rule: MaskRuleMobile, expect: "138****5678"},
+		{s: "110101199003070019", rule: MaskRuleIdCard, expect: "110***********0019"},
+		{s: "6222081203009584273", rule: MaskRuleBankCard, expect: "6222 **** **** 4273"},
+		{s: "1234567890123", rule: MaskRuleCorpAccount, expect: "1234*****0123"},
+		{s: "浙江省杭州市西湖区文三路100号", rule: MaskRuleAddress, expect: "浙江省杭州市西湖区*******"},
+		{s: "文三路100号", rule: MaskRuleAddress, expect: "文三路****"},
+		{s: "abcdef", rule: "keep=1,2", expect: "a***ef"},
+		{s: "abc", rule: "keep=1,2", expect: "***"},
+		{s: "abcdef", rule: "keep=x", expect: "******"},
+		{s: "abcdef", rule: MaskRuleAll, expect: "******"},
+		{s: "abcdef", rule: "unknown", expect: "******"},
+		{s: "", rule: MaskRuleAll, expect: ""},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expect, Mask(c.s, c.rule), c.s)
+	}
+
+	RegisterMaskRule("plate", func(s string) string { return MaskKeep(s, 2, 1) })
+	assert.Equal(t, "京A****5", Mask("京A12345", "plate"))
+}
+
+func TestMaskStruct(t *testing.T) {
+	mobile := "13812345678"
+	c := &_Customer{
+		Name:     "张小三",
+		IdCard:   "110101199003070019",
+		Mobile:   &mobile,
+		Email:    "zhangsan@example.com",
+		Address:  "北京市东城区东华门大街1号",
+		Cards:    []string{"6222081203009584273", "6225760008219524"},
+		Accounts: map[string]string{"icbc": "1234567890123"},
+		Token:    "abcdef",
+		Remark:   "vip",
+		Level:    3,
+		Contact:  &_Customer{Name: "李四", Extra: _Customer{Email: "lisi@example.com"}},
+		note:     "internal",
+	}
+
+	mc := MaskStruct(c)
+	require.NotSame(t, c, mc)
+	assert.Equal(t, "张*三", mc.Name)
+	assert.Equal(t, IdCard("110***********0019"), mc.IdCard)
+	assert.Equal(t, "138****5678", *mc.Mobile)
+	assert.Equal(t, "z******n@example.com", mc.Email)
+	assert.Equal(t, "北京市东城区*******", mc.Address)
+	assert.Equal(t, []string{"6222 **** **** 4273", "6225 **** **** 9524"}, mc.Cards)
+	assert.Equal(t, map[string]string{"icbc": "1234*****0123"}, mc.Accounts)
+	assert.Equal(t, "ab**ef", mc.Token)
+	assert.Equal(t, "***", mc.Remark)
+	assert.Equal(t, 3, mc.Level)
+	assert.Equal(t, "李*", mc.Contact.Name)
+	assert.Equal(t, "l**i@example.com", mc.Contact.Extra.(_Customer).Email)
+	assert.Equal(t, "internal", mc.note)
+
+	// the original value is not modified
+	assert.Equal(t, "张小三", c.Name)
+	assert.Equal(t, "13812345678", mobile)
+	assert.Equal(t, "6222081203009584273", c.Cards[0])
+	assert.Equal(t, "1234567890123", c.Accounts["icbc"])
+	assert.Equal(t, "lisi@example.com", c.Contact.Extra.(_Customer).Email)
+
+	assert.Equal(t, "张*三", MaskStruct(*c).Name)
+	assert.Nil(t, MaskStruct[*_Customer](nil))
+	assert.Nil(t, MaskStruct[any](nil))
+}
+
+func TestMaskStruct_Unexported(t *testing.T) {
+	a := _Account{User: "张小三", password: "secret", level: 1}
+	ma := MaskStruct(a)
+	assert.Equal(t, "张*三", ma.User)
+	assert.Empty(t, ma.password)
+	assert.Zero(t, ma.level)
+	assert.NotContains(t, fmt.Sprintf("%+v", NewSensitive(a)), "secret")
+	assert.Equal(t, "secret", a.password)
+
+	// the unexported field containing the fields with the mask tag is also sensitive
+	type holder struct {
+		customer _Customer
+	}
+	mh := MaskStruct(holder{customer: _Customer{Name: "张小三"}})
+	assert.Empty(t, mh.customer.Name)
+
+	// the unexported interface field is checked by the dynamic value
+	type box struct {
+		extra any
+	}
+	assert.Nil(t, MaskStruct(box{extra: &_Customer{Name: "张小三"}}).extra)
+	assert.Equal(t, "public", MaskStruct(box{extra: "public"}).extra)
+}
+
+type _inner struct {
+	ID     string `mask:"idcard"`
+	Note   string
+	secret string `mask:"all"`
+}
+
+type _Outer struct {
+	_inner
+	Name string `mask:"name"`
+}
+
+func TestMaskStruct_Embedded(t *testing.T) {
+	o := _Outer{_inner: _inner{ID: "110101199003070019", Note: "keep"}, Name: "张三丰"}
+	mo := MaskStruct(o)
+	assert.Equal(t, "110***********0019", mo.ID)
+	assert.Equal(t, "keep", mo.Note)
+	assert.Equal(t, "张*丰", mo.Name)
+	assert.Equal(t, "110101199003070019", o.ID)
+
+	// only the sensitive unexported fields of the embedded unexported struct are zeroed
+	o.secret = "p@ssw0rd"
+	mo = MaskStruct(o)
+	assert.Equal(t, "110***********0019", mo.ID)
+	assert.Equal(t, "keep", mo.Note)
+	assert.Empty(t, mo.secret)
+	assert.NotContains(t, fmt.Sprintf("%+v", NewSensitive(o)), "p@ssw0rd")
+}
+
+func TestMaskStruct_Cyclic(t *testing.T) {
+	n1 := &_Node{Name: "张小三"}
+	n2 := &_Node{Name: "李四", Next: n1}
+	n1.Next = n2
+
+	m1 := MaskStruct(n1)
+	assert.Equal(t, "张*三", m1.Name)
+	assert.Equal(t, "李*", m1.Next.Name)
+	assert.Same(t, m1, m1.Next.Next)
+	assert.Equal(t, "张小三", n1.Name)
+
+	assert.Contains(t, NewSensitive(n1).String(), "张*三")
+}
+
+func TestSensitive(t *testing.T) {
+	c := _Customer{Name: "张小三", IdCard: "110101199003070019"}
+
+	s := NewSensitive(c)
+	assert.Equal(t, "张*三", s.Value().(_Customer).Name)
+	assert.Contains(t, s.String(), "110***********0019")
+	assert.Contains(t, fmt.Sprintf("%+v", s), "Name:张*三")
+	assert.Contains(t, fmt.Sprintf("%#v", NewSensitive(&c)), `Name:"张*三"`)
+	assert.NotContains(t, fmt.Sprintf("%v %s %+v", s, s, s), "110101199003070019")
+
+	assert.Equal(t, "138****5678", fmt.Sprint(NewSensitive("13812345678", MaskRuleMobile)))
+	assert.Equal(t, `  "138****5678"`, fmt.Sprintf("%15q", NewSensitive("13812345678", MaskRuleMobile)))
+	assert.Equal(t, "13812345678", fmt.Sprint(NewSensitive("13812345678")))
+	assert.Equal(t, "<nil>", fmt.Sprint(NewSensitive(nil)))
+
+	b, err := json.Marshal(map[string]any{"customer": s})
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"idcard":"110***********0019"`)
+	assert.NotContains(t, string(b), "110101199003070019")
+}
@@ -0,0 +1,262 @@
+package validator
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// mainland china mobile phone number, ITU-T E.164, country code 86
+// the first 3 or 4 digits are the number segment allocated to the carrier by MIIT
+// number segments: https://zh.wikipedia.org/wiki/%E4%B8%AD%E5%9B%BD%E5%86%85%E5%9C%B0%E7%A7%BB%E5%8A%A8%E7%BB%88%E7%AB%AF%E9%80%9A%E8%AE%AF%E5%8F%B7%E6%AE%B5
+
+// Carrier mobile carrier.
+type Carrier int
+
+// mobile carriers.
+const (
+	// CarrierUnknown unknown carrier.
+	CarrierUnknown Carrier = iota
+	// CarrierMobile china mobile.
+	CarrierMobile
+	// CarrierUnicom china unicom.
+	CarrierUnicom
+	// CarrierTelecom china telecom.
+	CarrierTelecom
+	// CarrierBroadnet china broadnet.
+	CarrierBroadnet
+	// CarrierMobileVirtual virtual operator on the china mobile network.
+	CarrierMobileVirtual
+	// CarrierUnicomVirtual virtual operator on the china unicom network.
+	CarrierUnicomVirtual
+	// CarrierTelecomVirtual virtual operator on the china telecom network.
+	CarrierTelecomVirtual
+	// CarrierSatellite satellite communication.
+	CarrierSatellite
+)
+
+// carrierNameMap carrier - name map.
+var carrierNameMap = map[Carrier]string{
+	CarrierUnknown:        "未知",
+	CarrierMobile:         "中国移动",
+	CarrierUnicom:         "中国联通",
+	CarrierTelecom:        "中国电信",
+	CarrierBroadnet:       "中国广电",
+	CarrierMobileVirtual:  "中国移动虚拟运营商",
+	CarrierUnicomVirtual:  "中国联通虚拟运营商",
+	CarrierTelecomVirtual: "中国电信虚拟运营商",
+	CarrierSatellite:      "卫星通信",
+}
+
+// String returns the chinese name of the carrier.
+func (c Carrier) String() string {
+	if name, ok := carrierNameMap[c]; ok {
+		return name
+	}
+
+	return carrierNameMap[CarrierUnknown]
+}
+
+// IsVirtual reports whether the carrier is a virtual operator.
+func (c Carrier) IsVirtual() bool {
+	return c == CarrierMobileVirtual || c == CarrierUnicomVirtual || c == CarrierTelecomVirtual
+}
+
+// mobileData embedded number segment table, one "segment carrier" per line.
+//
+//go:embed data/mobile.txt
+var mobileData string
+
+var (
+	// mobileMutex mobile mutex, used to ensure concurrency security of loading number segments.
+	mobileMutex sync.RWMutex
+	// mobileSegmentCarrierMap segment - carrier map,
+	// key represents the 3 or 4 digits number segment, and value represents the carrier,
+	// 4 digits segments take precedence over 3 digits segments.
+	mobileSegmentCarrierMap = make(map[string]Carrier)
+
+	// carrierCodeMap carrier code of the number segment table - carrier map.
+	carrierCodeMap = map[string]Carrier{
+		"mobile":          CarrierMobile,
+		"unicom":          CarrierUnicom,
+		"telecom":         CarrierTelecom,
+		"broadnet":        CarrierBroadnet,
+		"mobile_virtual":  CarrierMobileVirtual,
+		"unicom_virtual":  CarrierUnicomVirtual,
+		"telecom_virtual": CarrierTelecomVirtual,
+		"satellite":       CarrierSatellite,
+	}
+)
+
+func init() {
+	if err := LoadMobileSegments(strings.NewReader(mobileData)); err != nil {
+		panic(err)
+	}
+}
+
+var (
+	// ErrInvalidMobile invalid mobile error.
+	ErrInvalidMobile = errors.New("validator: invalid mobile")
+	// ErrInvalidLandline invalid landline error.
+	ErrInvalidLandline = errors.New("validator: invalid landline")
+
+	// mobileSeparatorReplacer removes the separators of mobile and landline.
+	mobileSeparatorReplacer = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", "（", "", "）", "")
+)
+
+// Mobile mobile validator.
+type Mobile string
+
+// NewMobile new a mobile validator.
+func NewMobile(mobile string) Mobile {
+	return Mobile(mobile)
+}
+
+// Normalize normalizes the mobile to 11 digits,
+// the separators and the country code prefix +86, 0086 or 86 are removed.
+func (m Mobile) Normalize() string {
+	mStr := mobileSeparatorReplacer.Replace(strings.TrimSpace(string(m)))
+
+	switch {
+	case strings.HasPrefix(mStr, "+86"):
+		mStr = mStr[3:]
+	case strings.HasPrefix(mStr, "0086"):
+		mStr = mStr[4:]
+	case strings.HasPrefix(mStr, "86") && len(mStr) == 13:
+		mStr = mStr[2:]
+	}
+
+	return mStr
+}
+
+// IsValid checks the mobile is valid, the mobile must be allocated to a known carrier.
+func (m Mobile) IsValid() bool {
+	_, err := m.GetCarrier()
+	return err == nil
+}
+
+// Mask returns the masked mobile for display, which only keeps the first 3 and the last 4 digits
+// of the normalized mobile, e.g. 138****5678.
+func (m Mobile) Mask() string {
+	return MaskKeep(m.Normalize(), 3, 4)
+}
+
+// GetCarrier gets the carrier of the mobile by the number segment.
+func (m Mobile) GetCarrier() (Carrier, error) {
+	mStr := m.Normalize()
+	if !mobileRegex.MatchString(mStr) {
+		return CarrierUnknown, ErrInvalidMobile
+	}
+
+	mobileMutex.RLock()
+	defer mobileMutex.RUnlock()
+
+	if c, ok := mobileSegmentCarrierMap[mStr[:4]]; ok {
+		return c, nil
+	}
+	if c, ok := mobileSegmentCarrierMap[mStr[:3]]; ok {
+		return c, nil
+	}
+
+	return CarrierUnknown, ErrInvalidMobile
+}
+
+// LoadMobileSegments loads the number segments from the reader, one "segment carrier" per line,
+// e.g. 1349 satellite, blank lines and lines starting with # are ignored.
+// The loaded segments are added to the embedded table and override the existing carriers,
+// so that the newly allocated segments can be loaded without code changes.
+func LoadMobileSegments(r io.Reader) error {
+	segments := make(map[string]Carrier)
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || len(fields[0]) < 3 || len(fields[0]) > 4 || fields[0][0] != '1' || !isDigits(fields[0]) {
+			return errors.New("validator: invalid mobile segment line " + line)
+		}
+		c, ok := carrierCodeMap[fields[1]]
+		if !ok {
+			return errors.New("validator: invalid mobile segment line " + line)
+		}
+		segments[fields[0]] = c
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	mobileMutex.Lock()
+	defer mobileMutex.Unlock()
+
+	for segment, c := range segments {
+		mobileSegmentCarrierMap[segment] = c
+	}
+
+	return nil
+}
+
+// LandlineInfo parsed landline information.
+type LandlineInfo struct {
+	AreaCode  string // area code with the leading 0, e.g. 010, 0571, empty if absent
+	Number    string // local number of 7 or 8 digits
+	Extension string // extension number, empty if absent
+}
+
+// String returns the landline in the form of 010-12345678-123.
+func (li LandlineInfo) String() string {
+	s := li.Number
+	if li.AreaCode != "" {
+		s = li.AreaCode + "-" + s
+	}
+	if li.Extension != "" {
+		s += "-" + li.Extension
+	}
+
+	return s
+}
+
+// landlineRegex matches the landline like +86 10 12345678, (0571)8765432 and 010-12345678-123,
+// 010 and 02X are 3 digits area codes, and the others are 4 digits area codes.
+var landlineRegex = regexp.MustCompile(
+	`^(?:(?:\+86|0086)[ -]?(?:\(?0?(10|2[0-9]|[3-9][0-9]{2})\)?)[ -]?|(?:\(?(010|02[0-9]|0[3-9][0-9]{2})\)?)[ -]?)?` +
+		`([2-9][0-9]{6,7})(?:(?:-|转|ext\.?|#)([0-9]{1,6}))?$`)
+
+// Landline landline validator.
+type Landline string
+
+// NewLandline new a landline validator.
+func NewLandline(landline string) Landline {
+	return Landline(landline)
+}
+
+// IsValid checks the landline is valid.
+func (l Landline) IsValid() bool {
+	_, err := l.Parse()
+	return err == nil
+}
+
+// Parse parses the landline into area code, local number and extension.
+func (l Landline) Parse() (*LandlineInfo, error) {
+	m := landlineRegex.FindStringSubmatch(strings.TrimSpace(string(l)))
+	if m == nil {
+		return nil, ErrInvalidLandline
+	}
+
+	li := &LandlineInfo{Number: m[3], Extension: m[4]}
+	switch {
+	case m[1] != "":
+		li.AreaCode = "0" + m[1]
+	case m[2] != "":
+		li.AreaCode = m[2]
+	}
+
+	return li, nil
+}
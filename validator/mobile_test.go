@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMobile_IsValid(t *testing.T) {
+	cases := []struct {
+		mobile        string
+		expect        bool
+		expectCarrier Carrier
+	}{
+		{mobile: "13800138000", expect: true, expectCarrier: CarrierMobile},
+		{mobile: "+86 138-0013-8000", expect: true, expectCarrier: CarrierMobile},
+		{mobile: "008618612345678", expect: true, expectCarrier: CarrierUnicom},
+		{mobile: "8618912345678", expect: true, expectCarrier: CarrierTelecom},
+		{mobile: "19212345678", expect: true, expectCarrier: CarrierBroadnet},
+		{mobile: "17051234567", expect: true, expectCarrier: CarrierMobileVirtual},
+		{mobile: "17112345678", expect: true, expectCarrier: CarrierUnicomVirtual},
+		{mobile: "17001234567", expect: true, expectCarrier: CarrierTelecomVirtual},
+		{mobile: "17401234567", expect: true, expectCarrier: CarrierSatellite},
+		{mobile: "13491234567", expect: true, expectCarrier: CarrierSatellite},
+		{mobile: "13412345678", expect: true, expectCarrier: CarrierMobile},
+		{mobile: "14012345678", expect: false, expectCarrier: CarrierUnknown},
+		{mobile: "12345678901", expect: false, expectCarrier: CarrierUnknown},
+		{mobile: "1380013800", expect: false, expectCarrier: CarrierUnknown},
+		{mobile: "", expect: false, expectCarrier: CarrierUnknown},
+	}
+
+	for _, c := range cases {
+		get := NewMobile(c.mobile)
+		assert.Equal(t, c.expect, get.IsValid(), c.mobile)
+		carrier, err := get.GetCarrier()
+		if c.expect {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid mobile")
+		}
+		assert.Equal(t, c.expectCarrier, carrier, c.mobile)
+	}
+
+	assert.Equal(t, "13800138000", NewMobile("+86 (138) 0013 8000").Normalize())
+	assert.Equal(t, "中国移动", CarrierMobile.String())
+	assert.Equal(t, "未知", Carrier(100).String())
+	assert.True(t, CarrierUnicomVirtual.IsVirtual())
+	assert.False(t, CarrierUnicom.IsVirtual())
+}
+
+func TestLoadMobileSegments(t *testing.T) {
+	assert.False(t, NewMobile("14012345678").IsValid())
+	err := LoadMobileSegments(strings.NewReader("# comment\n\n140 mobile_virtual\n"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mobileMutex.Lock()
+		delete(mobileSegmentCarrierMap, "140")
+		mobileMutex.Unlock()
+	})
+
+	carrier, err := NewMobile("14012345678").GetCarrier()
+	require.NoError(t, err)
+	assert.Equal(t, CarrierMobileVirtual, carrier)
+
+	require.Error(t, LoadMobileSegments(strings.NewReader("140 unknown\n")))
+	require.Error(t, LoadMobileSegments(strings.NewReader("14 mobile\n")))
+	require.Error(t, LoadMobileSegments(strings.NewReader("24012 mobile\n")))
+	require.Error(t, LoadMobileSegments(strings.NewReader("140\n")))
+}
+
+func TestLandline_Parse(t *testing.T) {
+	cases := []struct {
+		landline string
+		expect   *LandlineInfo
+	}{
+		{landline: "010-12345678", expect: nil},
+		{landline: "010-22345678", expect: &LandlineInfo{AreaCode: "010", Number: "22345678"}},
+		{landline: "02087654321", expect: &LandlineInfo{AreaCode: "020", Number: "87654321"}},
+		{landline: "(0571)8765432", expect: &LandlineInfo{AreaCode: "0571", Number: "8765432"}},
+		{landline: "0571 87654321-123", expect: &LandlineInfo{AreaCode: "0571", Number: "87654321", Extension: "123"}},
+		{landline: "+86 10 62345678", expect: &LandlineInfo{AreaCode: "010", Number: "62345678"}},
+		{landline: "0086-571-87654321转8001", expect: &LandlineInfo{AreaCode: "0571", Number: "87654321", Extension: "8001"}},
+		{landline: "87654321", expect: &LandlineInfo{Number: "87654321"}},
+		{landline: "0571-12345678", expect: nil},
+		{landline: "0123-87654321", expect: nil},
+		{landline: "13800138000", expect: nil},
+		{landline: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewLandline(c.landline)
+		info, err := get.Parse()
+		if c.expect == nil {
+			require.EqualError(t, err, "validator: invalid landline", c.landline)
+			assert.False(t, get.IsValid())
+			continue
+		}
+		require.NoError(t, err, c.landline)
+		assert.Equal(t, c.expect, info, c.landline)
+		assert.True(t, get.IsValid())
+	}
+
+	assert.Equal(t, "0571-87654321-123", LandlineInfo{AreaCode: "0571", Number: "87654321", Extension: "123"}.String())
+	assert.Equal(t, "87654321", LandlineInfo{Number: "87654321"}.String())
+}
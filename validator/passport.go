@@ -0,0 +1,96 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// passport of the people's republic of china, and mainland travel permits for hong kong, macau and taiwan residents
+// passport: E (electronic ordinary passport) + 8 digits or a letter and 7 digits, G (ordinary passport) + 8 digits,
+// D (diplomatic passport), S (service passport) or P (public affairs ordinary passport) + 7 digits
+// mainland travel permit for hong kong and macau residents: H (hong kong) or M (macau) + 8 digits,
+// the old permits have 2 more digits which represent the renewal count
+// mainland travel permit for taiwan residents: 8 digits, the old permits have 10 digits and an optional renewal letter
+
+var (
+	// ErrInvalidPassport invalid passport error.
+	ErrInvalidPassport = errors.New("validator: invalid passport")
+	// ErrInvalidTravelPermit invalid travel permit error.
+	ErrInvalidTravelPermit = errors.New("validator: invalid travel permit")
+
+	passportRegex         = regexp.MustCompile(`^(?:E[0-9]{8}|E[A-HJ-NP-Z][0-9]{7}|G[0-9]{8}|[DSP][0-9]{7})$`)
+	hkMacauPermitRegex    = regexp.MustCompile(`^([HM])([0-9]{8})([0-9]{2})?$`)
+	taiwanPermitRegex     = regexp.MustCompile(`^[0-9]{8}$`)
+	taiwanPermitOldRegex  = regexp.MustCompile(`^[0-9]{10}(?:\(?[A-Z]\)?)?$`)
+	passportTypeNameMap   = map[uint8]string{'E': "普通电子护照", 'G': "普通护照", 'D': "外交护照", 'S': "公务护照", 'P': "公务普通护照"}
+	travelPermitRegionMap = map[uint8]string{'H': "香港", 'M': "澳门"}
+)
+
+// Passport passport validator.
+type Passport string
+
+// NewPassport new a passport validator.
+func NewPassport(passport string) Passport {
+	return Passport(passport)
+}
+
+// IsValid checks the passport is valid.
+func (p Passport) IsValid() bool {
+	return passportRegex.MatchString(strings.ToUpper(string(p)))
+}
+
+// GetType gets the passport type, e.g. 普通电子护照.
+func (p Passport) GetType() (string, error) {
+	if !p.IsValid() {
+		return "", ErrInvalidPassport
+	}
+
+	return passportTypeNameMap[strings.ToUpper(string(p))[0]], nil
+}
+
+// TravelPermitInfo parsed travel permit information.
+type TravelPermitInfo struct {
+	Region     string // region of the holder, 香港, 澳门 or 台湾
+	Number     string // permit number without the renewal count
+	RenewCount int    // renewal count of the old hong kong and macau permits, -1 if absent
+	Old        bool   // whether the permit is in the old format
+}
+
+// TravelPermit mainland travel permit for hong kong, macau and taiwan residents validator.
+type TravelPermit string
+
+// NewTravelPermit new a mainland travel permit validator.
+func NewTravelPermit(permit string) TravelPermit {
+	return TravelPermit(permit)
+}
+
+// IsValid checks the mainland travel permit is valid.
+func (tp TravelPermit) IsValid() bool {
+	_, err := tp.Parse()
+	return err == nil
+}
+
+// Parse parses the mainland travel permit.
+func (tp TravelPermit) Parse() (*TravelPermitInfo, error) {
+	tpStr := strings.ToUpper(strings.TrimSpace(string(tp)))
+
+	if m := hkMacauPermitRegex.FindStringSubmatch(tpStr); m != nil {
+		info := &TravelPermitInfo{Region: travelPermitRegionMap[m[1][0]], Number: m[1] + m[2], RenewCount: -1}
+		if m[3] != "" {
+			info.RenewCount, _ = strconv.Atoi(m[3])
+			info.Old = true
+		}
+		return info, nil
+	}
+
+	switch {
+	case taiwanPermitRegex.MatchString(tpStr):
+		return &TravelPermitInfo{Region: "台湾", Number: tpStr, RenewCount: -1}, nil
+	case taiwanPermitOldRegex.MatchString(tpStr):
+		return &TravelPermitInfo{Region: "台湾", Number: tpStr[:10], RenewCount: -1, Old: true}, nil
+	default:
+		return nil, ErrInvalidTravelPermit
+	}
+}
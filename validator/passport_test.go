@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassport_IsValid(t *testing.T) {
+	cases := []struct {
+		passport   string
+		expect     bool
+		expectType string
+	}{
+		{passport: "E12345678", expect: true, expectType: "普通电子护照"},
+		{passport: "EA1234567", expect: true, expectType: "普通电子护照"},
+		{passport: "g12345678", expect: true, expectType: "普通护照"},
+		{passport: "D1234567", expect: true, expectType: "外交护照"},
+		{passport: "S1234567", expect: true, expectType: "公务护照"},
+		{passport: "P1234567", expect: true, expectType: "公务普通护照"},
+		{passport: "EI1234567", expect: false},
+		{passport: "G1234567", expect: false},
+		{passport: "K12345678", expect: false},
+		{passport: "", expect: false},
+	}
+
+	for _, c := range cases {
+		get := NewPassport(c.passport)
+		assert.Equal(t, c.expect, get.IsValid(), c.passport)
+		typ, err := get.GetType()
+		if c.expect {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid passport")
+		}
+		assert.Equal(t, c.expectType, typ, c.passport)
+	}
+}
+
+func TestTravelPermit_Parse(t *testing.T) {
+	cases := []struct {
+		permit string
+		expect *TravelPermitInfo
+	}{
+		{permit: "H12345678", expect: &TravelPermitInfo{Region: "香港", Number: "H12345678", RenewCount: -1}},
+		{permit: "m1234567801", expect: &TravelPermitInfo{Region: "澳门", Number: "M12345678", RenewCount: 1, Old: true}},
+		{permit: "12345678", expect: &TravelPermitInfo{Region: "台湾", Number: "12345678", RenewCount: -1}},
+		{permit: "1234567890(B)", expect: &TravelPermitInfo{Region: "台湾", Number: "1234567890", RenewCount: -1, Old: true}},
+		{permit: "H1234567", expect: nil},
+		{permit: "X12345678", expect: nil},
+		{permit: "123456789", expect: nil},
+		{permit: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewTravelPermit(c.permit)
+		assert.Equal(t, c.expect != nil, get.IsValid(), c.permit)
+		info, err := get.Parse()
+		if c.expect != nil {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid travel permit")
+		}
+		assert.Equal(t, c.expect, info, c.permit)
+	}
+}
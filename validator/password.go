@@ -0,0 +1,498 @@
+package validator
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	ut "github.com/go-playground/universal-translator"
+	validator "github.com/go-playground/validator/v10"
+)
+
+// password strength, the score from 0 to 4 is estimated like zxcvbn,
+// the password is split into the tokens of the common passwords, the sequences, the repeated characters
+// and the single characters, and the entropy is the sum of the bits of the tokens.
+
+// password policy names of the password tag, e.g. password=strong.
+const (
+	PasswordWeak   = "weak"
+	PasswordMedium = "medium"
+	PasswordStrong = "strong"
+)
+
+// password rules.
+const (
+	PasswordRuleLength   = "length"
+	PasswordRuleClasses  = "classes"
+	PasswordRuleSequence = "sequence"
+	PasswordRuleRepeat   = "repeat"
+	PasswordRuleCommon   = "common"
+	PasswordRuleScore    = "score"
+	PasswordRulePolicy   = "policy"
+)
+
+// ErrInvalidPasswordPolicy invalid password policy error.
+var ErrInvalidPasswordPolicy = errors.New("validator: invalid password policy")
+
+// PasswordPolicy password policy.
+type PasswordPolicy struct {
+	MinLength     int  // minimum length, 0 means no limit
+	MaxLength     int  // maximum length, 0 means no limit
+	MinClasses    int  // minimum number of character classes among lower case, upper case, digit and symbol
+	RequireLower  bool // whether the lower case letter is required
+	RequireUpper  bool // whether the upper case letter is required
+	RequireDigit  bool // whether the digit is required
+	RequireSymbol bool // whether the symbol is required
+	MaxSequence   int  // maximum length of the sequential characters like abc, 321 and qwe, 0 means no limit
+	MaxRepeat     int  // maximum length of the repeated characters like aaa, 0 means no limit
+	ForbidCommon  bool // whether the common leaked passwords are forbidden
+	MinScore      int  // minimum strength score from 0 to 4
+}
+
+// predefined password policies.
+var (
+	// PasswordPolicyWeak weak password policy.
+	PasswordPolicyWeak = PasswordPolicy{MinLength: 6, MaxLength: 64, ForbidCommon: true}
+	// PasswordPolicyMedium medium password policy, which is the default policy.
+	PasswordPolicyMedium = PasswordPolicy{
+		MinLength: 8, MaxLength: 64, MinClasses: 2, MaxSequence: 4, MaxRepeat: 3, ForbidCommon: true, MinScore: 2,
+	}
+	// PasswordPolicyStrong strong password policy.
+	PasswordPolicyStrong = PasswordPolicy{
+		MinLength: 10, MaxLength: 64, MinClasses: 3, MaxSequence: 3, MaxRepeat: 2, ForbidCommon: true, MinScore: 3,
+	}
+
+	// passwordPolicyMap name - password policy map.
+	passwordPolicyMap = map[string]*PasswordPolicy{
+		PasswordWeak:   &PasswordPolicyWeak,
+		PasswordMedium: &PasswordPolicyMedium,
+		PasswordStrong: &PasswordPolicyStrong,
+	}
+)
+
+// PasswordError password error, which explains the failed rule in chinese.
+type PasswordError struct {
+	Rule    string // failed rule, e.g. length
+	Message string // chinese explanation without the subject, e.g. 长度不能少于8位
+}
+
+// Error returns the chinese explanation of the failed rule, e.g. 密码长度不能少于8位.
+func (e *PasswordError) Error() string {
+	return "密码" + e.Message
+}
+
+// passwordsData embedded common password table, one lower case password per line.
+//
+//go:embed data/passwords.txt
+var passwordsData string
+
+var (
+	// passwordMutex password mutex, used to ensure concurrency security of loading passwords.
+	passwordMutex sync.RWMutex
+	// commonPasswordMap common passwords.
+	commonPasswordMap = make(map[string]struct{})
+	// commonPasswordMaxLength length of the longest common password, which limits the scan window.
+	commonPasswordMaxLength int
+
+	// passwordSequences sequences of the alphabet, the digits and the keyboard walks.
+	passwordSequences = []string{
+		"abcdefghijklmnopqrstuvwxyz",
+		"01234567890",
+		"qwertyuiop", "asdfghjkl", "zxcvbnm",
+		"1qaz2wsx3edc4rfv5tgb6yhn7ujm8ik9ol0p",
+		"!@#$%^&*()",
+	}
+
+	// passwordLeetReplacer reverses the common substitutions of the passwords.
+	passwordLeetReplacer = strings.NewReplacer(
+		"@", "a", "4", "a", "3", "e", "1", "i", "!", "i", "0", "o", "$", "s", "5", "s", "7", "t", "+", "t")
+)
+
+func init() {
+	if err := LoadPasswords(strings.NewReader(passwordsData)); err != nil {
+		panic(err)
+	}
+}
+
+// LoadPasswords loads the common passwords from the reader, one password per line,
+// blank lines and lines starting with # are ignored, the passwords are added to the embedded table.
+func LoadPasswords(r io.Reader) error {
+	var passwords []string
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords = append(passwords, strings.ToLower(line))
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	passwordMutex.Lock()
+	defer passwordMutex.Unlock()
+
+	for _, p := range passwords {
+		commonPasswordMap[p] = struct{}{}
+		if n := utf8.RuneCountInString(p); n > commonPasswordMaxLength {
+			commonPasswordMaxLength = n
+		}
+	}
+
+	return nil
+}
+
+// Password password validator.
+type Password string
+
+// NewPassword new a password validator.
+func NewPassword(password string) Password {
+	return Password(password)
+}
+
+// IsValid checks the password satisfies the policy, PasswordPolicyMedium is used if the policy is absent.
+func (p Password) IsValid(policy ...*PasswordPolicy) bool {
+	return p.Check(policy...) == nil
+}
+
+// Check checks the password against the policy and returns the *PasswordError of the first failed rule,
+// PasswordPolicyMedium is used if the policy is absent.
+func (p Password) Check(policy ...*PasswordPolicy) error {
+	pp := &PasswordPolicyMedium
+	if len(policy) > 0 && policy[0] != nil {
+		pp = policy[0]
+	}
+
+	runes := []rune(string(p))
+	switch {
+	case pp.MinLength > 0 && len(runes) < pp.MinLength:
+		return &PasswordError{Rule: PasswordRuleLength, Message: "长度不能少于" + strconv.Itoa(pp.MinLength) + "位"}
+	case pp.MaxLength > 0 && len(runes) > pp.MaxLength:
+		return &PasswordError{Rule: PasswordRuleLength, Message: "长度不能超过" + strconv.Itoa(pp.MaxLength) + "位"}
+	}
+
+	lower, upper, digit, symbol := passwordClasses(runes)
+	switch {
+	case pp.RequireLower && !lower:
+		return &PasswordError{Rule: PasswordRuleClasses, Message: "必须包含小写字母"}
+	case pp.RequireUpper && !upper:
+		return &PasswordError{Rule: PasswordRuleClasses, Message: "必须包含大写字母"}
+	case pp.RequireDigit && !digit:
+		return &PasswordError{Rule: PasswordRuleClasses, Message: "必须包含数字"}
+	case pp.RequireSymbol && !symbol:
+		return &PasswordError{Rule: PasswordRuleClasses, Message: "必须包含特殊字符"}
+	case countTrue(lower, upper, digit, symbol) < pp.MinClasses:
+		return &PasswordError{
+			Rule:    PasswordRuleClasses,
+			Message: "必须包含小写字母、大写字母、数字和特殊字符中的至少" + strconv.Itoa(pp.MinClasses) + "种",
+		}
+	}
+
+	lowerRunes := []rune(strings.ToLower(string(p)))
+	if pp.MaxSequence > 0 {
+		if seq := longestSequence(lowerRunes); len(seq) > pp.MaxSequence {
+			return &PasswordError{Rule: PasswordRuleSequence, Message: "不能包含连续的字符序列“" + string(seq) + "”"}
+		}
+	}
+	if pp.MaxRepeat > 0 {
+		if n := longestRepeat(lowerRunes); n > pp.MaxRepeat {
+			return &PasswordError{
+				Rule:    PasswordRuleRepeat,
+				Message: "不能包含超过" + strconv.Itoa(pp.MaxRepeat) + "个连续重复的字符",
+			}
+		}
+	}
+	if pp.ForbidCommon && p.IsCommon() {
+		return &PasswordError{Rule: PasswordRuleCommon, Message: "不能使用常见的弱密码"}
+	}
+	if score := p.Score(); score < pp.MinScore {
+		return &PasswordError{Rule: PasswordRuleScore, Message: "强度不足，请使用更长或更复杂的密码"}
+	}
+
+	return nil
+}
+
+// IsCommon reports whether the password is a common leaked password,
+// the common substitutions like P@ssw0rd are also recognized.
+func (p Password) IsCommon() bool {
+	lower := strings.ToLower(string(p))
+
+	passwordMutex.RLock()
+	defer passwordMutex.RUnlock()
+
+	return isCommonPassword(lower) || isCommonPassword(passwordLeetReplacer.Replace(lower))
+}
+
+// Entropy estimates the entropy bits of the password.
+func (p Password) Entropy() float64 {
+	runes := []rune(string(p))
+	lowerRunes := []rune(strings.ToLower(string(p)))
+	leetRunes := []rune(passwordLeetReplacer.Replace(strings.ToLower(string(p))))
+	if len(leetRunes) != len(lowerRunes) {
+		leetRunes = lowerRunes
+	}
+	charset := passwordCharset(runes)
+
+	passwordMutex.RLock()
+	defer passwordMutex.RUnlock()
+
+	bits := 0.0
+	for i := 0; i < len(runes); {
+		// the common password token is valued by the size of the table, and 1 bit for the upper case
+		if n := commonPasswordLength(lowerRunes, leetRunes, i); n > 0 {
+			bits += math.Log2(float64(len(commonPasswordMap)))
+			if strings.ToLower(string(runes[i:i+n])) != string(runes[i:i+n]) {
+				bits++
+			}
+			i += n
+			continue
+		}
+
+		// the sequence and the repeated characters are valued by the first character and the length
+		n := sequenceLength(lowerRunes[i:])
+		if r := repeatLength(lowerRunes[i:]); r > n {
+			n = r
+		}
+		if n >= 3 {
+			bits += math.Log2(passwordCharset(runes[i:i+1])) + math.Log2(float64(n))
+			i += n
+			continue
+		}
+
+		bits += math.Log2(charset)
+		i++
+	}
+
+	return bits
+}
+
+// Score gets the strength score of the password from 0 (too guessable) to 4 (very unguessable).
+func (p Password) Score() int {
+	if p == "" || p.IsCommon() {
+		return 0
+	}
+
+	switch bits := p.Entropy(); {
+	case bits < 20:
+		return 0
+	case bits < 30:
+		return 1
+	case bits < 45:
+		return 2
+	case bits < 60:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// RegisterPasswordPolicy registers the password policy of the name, which can be used by the password tag,
+// e.g. password=admin, the predefined policies can be overridden.
+// The policies should be registered before validating, which is not concurrency safe.
+func RegisterPasswordPolicy(name string, policy PasswordPolicy) error {
+	switch {
+	case name == "" || strings.ContainsAny(name, ",|= "),
+		policy.MinLength < 0, policy.MaxLength < 0,
+		policy.MaxLength > 0 && policy.MinLength > policy.MaxLength,
+		policy.MinClasses < 0, policy.MinClasses > 4,
+		policy.MaxSequence < 0, policy.MaxRepeat < 0,
+		policy.MinScore < 0, policy.MinScore > 4:
+		return fmt.Errorf("%w, %s", ErrInvalidPasswordPolicy, name)
+	}
+
+	passwordPolicyMap[name] = &policy
+
+	return nil
+}
+
+// password represents the password validator, the param is the policy name, e.g. password=strong,
+// and the medium policy is used if the param is absent, the password is invalid if the policy is unknown.
+func password(fl validator.FieldLevel) bool {
+	return checkPassword(fl.Field().String(), fl.Param()) == nil
+}
+
+// passwordTranslationFunc translates the password validation error to the chinese explanation
+// of the failed rule, the other locales use the translation of the password tag.
+func passwordTranslationFunc(ut ut.Translator, fe validator.FieldError) string {
+	var pe *PasswordError
+	if s, ok := fe.Value().(string); ok && ut.Locale() == LocaleZh && errors.As(checkPassword(s, fe.Param()), &pe) {
+		return fe.Field() + pe.Message
+	}
+
+	t, _ := ut.T("password", fe.Field(), fe.Param())
+	return t
+}
+
+// checkPassword checks the password against the policy of the name.
+func checkPassword(s, name string) error {
+	pp, ok := passwordPolicy(name)
+	if !ok {
+		return &PasswordError{Rule: PasswordRulePolicy, Message: "策略" + name + "不存在"}
+	}
+
+	return NewPassword(s).Check(pp)
+}
+
+// passwordPolicy gets the password policy by the name.
+func passwordPolicy(name string) (*PasswordPolicy, bool) {
+	if name == "" {
+		return &PasswordPolicyMedium, true
+	}
+	pp, ok := passwordPolicyMap[name]
+
+	return pp, ok
+}
+
+// passwordClasses reports whether the password contains lower case letters, upper case letters,
+// digits and symbols, the other characters like chinese are regarded as symbols.
+func passwordClasses(runes []rune) (lower, upper, digit, symbol bool) {
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case !unicode.IsSpace(r) || r == ' ':
+			symbol = true
+		}
+	}
+
+	return lower, upper, digit, symbol
+}
+
+// passwordCharset returns the size of the character set of the password.
+func passwordCharset(runes []rune) float64 {
+	lower, upper, digit, symbol := passwordClasses(runes)
+
+	charset := 0.0
+	if lower {
+		charset += 26
+	}
+	if upper {
+		charset += 26
+	}
+	if digit {
+		charset += 10
+	}
+	if symbol {
+		charset += 33
+	}
+	if charset == 0 {
+		charset = 1
+	}
+
+	return charset
+}
+
+// countTrue counts the true values.
+func countTrue(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+
+	return n
+}
+
+// longestSequence returns the longest sequential characters in the password,
+// both the forward and the backward sequences are found.
+func longestSequence(runes []rune) []rune {
+	var longest []rune
+	for i := range runes {
+		if n := sequenceLength(runes[i:]); n > len(longest) {
+			longest = runes[i : i+n]
+		}
+	}
+
+	return longest
+}
+
+// sequenceLength returns the length of the sequential characters at the beginning of the password.
+func sequenceLength(runes []rune) int {
+	longest := 0
+	for _, seq := range passwordSequences {
+		for _, s := range [2][]rune{[]rune(seq), reverseRunes([]rune(seq))} {
+			for j := range s {
+				n := 0
+				for n < len(runes) && j+n < len(s) && runes[n] == s[j+n] {
+					n++
+				}
+				if n > longest {
+					longest = n
+				}
+			}
+		}
+	}
+
+	return longest
+}
+
+// longestRepeat returns the length of the longest repeated characters in the password.
+func longestRepeat(runes []rune) int {
+	longest := 0
+	for i := 0; i < len(runes); {
+		n := repeatLength(runes[i:])
+		if n > longest {
+			longest = n
+		}
+		i += n
+	}
+
+	return longest
+}
+
+// repeatLength returns the length of the repeated characters at the beginning of the password.
+func repeatLength(runes []rune) int {
+	n := 0
+	for n < len(runes) && runes[n] == runes[0] {
+		n++
+	}
+
+	return n
+}
+
+// commonPasswordLength returns the length of the longest common password of at least 4 characters
+// starting from the index, 0 is returned if absent.
+func commonPasswordLength(lowerRunes, leetRunes []rune, from int) int {
+	n := len(lowerRunes) - from
+	if n > commonPasswordMaxLength {
+		n = commonPasswordMaxLength
+	}
+	for ; n >= 4; n-- {
+		if isCommonPassword(string(lowerRunes[from:from+n])) || isCommonPassword(string(leetRunes[from:from+n])) {
+			return n
+		}
+	}
+
+	return 0
+}
+
+// isCommonPassword reports whether the lower case password is in the common password table.
+func isCommonPassword(lower string) bool {
+	_, ok := commonPasswordMap[lower]
+	return ok
+}
+
+// reverseRunes returns the reversed copy of the runes.
+func reverseRunes(runes []rune) []rune {
+	reversed := make([]rune, len(runes))
+	for i, r := range runes {
+		reversed[len(runes)-1-i] = r
+	}
+
+	return reversed
+}
@@ -0,0 +1,148 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _RegisterReq struct {
+	Password      string `json:"password" validate:"password"`
+	AdminPassword string `json:"admin_password" validate:"omitempty,password=strong"`
+}
+
+func TestPassword_Check(t *testing.T) {
+	cases := []struct {
+		password string
+		policy   *PasswordPolicy
+		rule     string
+	}{
+		{password: "x7Kp2mQ9vL", policy: &PasswordPolicyStrong, rule: ""},
+		{password: "k9#Lm2$xQ7", policy: &PasswordPolicyStrong, rule: ""},
+		{password: "a1b2c3d4", policy: nil, rule: ""},
+		{password: "a1b2c3", policy: nil, rule: PasswordRuleLength},
+		{password: strings.Repeat("a1", 33), policy: nil, rule: PasswordRuleLength},
+		{password: "abcdefgh", policy: nil, rule: PasswordRuleClasses},
+		{password: "zhangsan1990", policy: &PasswordPolicyStrong, rule: PasswordRuleClasses},
+		{password: "qwerty!2024", policy: nil, rule: PasswordRuleSequence},
+		{password: "aaaaaaaa1", policy: nil, rule: PasswordRuleRepeat},
+		{password: "123456", policy: &PasswordPolicyWeak, rule: PasswordRuleCommon},
+		{password: "P@ssw0rd", policy: nil, rule: PasswordRuleCommon},
+		{password: "abcd1234x", policy: nil, rule: PasswordRuleScore},
+		{password: "Summer2024!", policy: &PasswordPolicyStrong, rule: PasswordRuleScore},
+		{password: "abc123", policy: &PasswordPolicy{RequireUpper: true}, rule: PasswordRuleClasses},
+		{password: "Abc123", policy: &PasswordPolicy{RequireUpper: true}, rule: ""},
+	}
+
+	for _, c := range cases {
+		p := NewPassword(c.password)
+		err := p.Check(c.policy)
+		assert.Equal(t, c.rule == "", p.IsValid(c.policy), c.password)
+		if c.rule == "" {
+			assert.NoError(t, err, c.password)
+			continue
+		}
+		var pe *PasswordError
+		require.True(t, errors.As(err, &pe), c.password)
+		assert.Equal(t, c.rule, pe.Rule, c.password)
+	}
+
+	assert.EqualError(t, NewPassword("a1b2c3").Check(), "密码长度不能少于8位")
+	assert.EqualError(t, NewPassword("qwerty!2024").Check(), "密码不能包含连续的字符序列“qwerty”")
+}
+
+func TestPassword_Score(t *testing.T) {
+	cases := []struct {
+		password string
+		expect   int
+	}{
+		{password: "", expect: 0},
+		{password: "password", expect: 0},
+		{password: "P@ssw0rd", expect: 0},
+		{password: "abcd1234x", expect: 0},
+		{password: "aaaaaaaa1", expect: 1},
+		{password: "a1b2c3d4", expect: 2},
+		{password: "x7Kp2mQ9vL", expect: 3},
+		{password: "Tr0ub4dor&3", expect: 4},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expect, NewPassword(c.password).Score(), c.password)
+	}
+
+	assert.True(t, NewPassword("Password").IsCommon())
+	assert.True(t, NewPassword("p@ssw0rd").IsCommon())
+	assert.False(t, NewPassword("x7Kp2mQ9vL").IsCommon())
+	assert.Less(t, NewPassword("password1").Entropy(), NewPassword("x7Kp2mQ9vL").Entropy())
+}
+
+func TestLoadPasswords(t *testing.T) {
+	passwords, maxLength := make(map[string]struct{}, len(commonPasswordMap)), commonPasswordMaxLength
+	for p := range commonPasswordMap {
+		passwords[p] = struct{}{}
+	}
+	t.Cleanup(func() {
+		commonPasswordMap, commonPasswordMaxLength = passwords, maxLength
+	})
+
+	assert.False(t, NewPassword("Zq8!Lw3#Np").IsCommon())
+	require.NoError(t, LoadPasswords(strings.NewReader("# leaked\n\nZq8!Lw3#Np\n")))
+	assert.True(t, NewPassword("Zq8!Lw3#Np").IsCommon())
+	assert.Equal(t, PasswordRuleCommon, NewPassword("Zq8!Lw3#Np").Check().(*PasswordError).Rule)
+
+	// the scan window grows with the longest common password
+	long := "correcthorsebatterystaple"
+	require.NoError(t, LoadPasswords(strings.NewReader(long)))
+	assert.Equal(t, len(long), commonPasswordMaxLength)
+	assert.Equal(t, len(long), commonPasswordLength([]rune(long+"1"), []rune(long+"1"), 0))
+}
+
+func TestRegisterPasswordPolicy(t *testing.T) {
+	t.Cleanup(func() { delete(passwordPolicyMap, "pin") })
+
+	require.NoError(t, RegisterPasswordPolicy("pin", PasswordPolicy{MinLength: 6, MaxLength: 6, RequireDigit: true}))
+	require.NoError(t, VerifyVar("839201", "password=pin"))
+	require.Error(t, VerifyVar("8392010", "password=pin"))
+
+	require.ErrorIs(t, RegisterPasswordPolicy("", PasswordPolicy{}), ErrInvalidPasswordPolicy)
+	require.ErrorIs(t, RegisterPasswordPolicy("a,b", PasswordPolicy{}), ErrInvalidPasswordPolicy)
+	require.ErrorIs(t, RegisterPasswordPolicy("bad", PasswordPolicy{MinLength: 8, MaxLength: 6}), ErrInvalidPasswordPolicy)
+	require.ErrorIs(t, RegisterPasswordPolicy("bad", PasswordPolicy{MinScore: 5}), ErrInvalidPasswordPolicy)
+	require.ErrorIs(t, RegisterPasswordPolicy("bad", PasswordPolicy{MinClasses: -1}), ErrInvalidPasswordPolicy)
+}
+
+func TestValidator_Password(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.TranslateAll(v.V.Struct(_RegisterReq{Password: "abcdefgh"}))
+	require.EqualError(t, err, "password必须包含小写字母、大写字母、数字和特殊字符中的至少2种")
+
+	err = v.TranslateAll(v.V.Struct(_RegisterReq{Password: "a1b2c3d4", AdminPassword: "a1b2c3d4"}), LocaleEn)
+	require.EqualError(t, err, "admin_password is not strong enough")
+	err = v.TranslateAll(v.V.Struct(_RegisterReq{Password: "a1b2c3d4", AdminPassword: "a1b2c3d4"}), "zh-TW")
+	require.EqualError(t, err, "admin_password強度不足")
+
+	var ves ValidationErrors
+	err = v.Translate(v.V.Struct(_RegisterReq{Password: "123456"}))
+	require.True(t, errors.As(err, &ves))
+	assert.Equal(t, "VALIDATION_PASSWORD", ves[0].Code)
+	assert.Equal(t, "password长度不能少于8位", ves[0].Message)
+
+	require.NoError(t, v.V.Struct(_RegisterReq{Password: "a1b2c3d4", AdminPassword: "x7Kp2mQ9vL"}))
+
+	// the unknown policy fails the validation instead of panicking
+	require.Error(t, v.V.Var("a1b2c3d4", "password=unknown"))
+	err = v.TranslateAll(v.V.Var("a1b2c3d4", "password=unknown"))
+	require.EqualError(t, err, "策略unknown不存在")
+
+	// the overridden chinese translation keeps the explanation of the failed rule
+	require.NoError(t, v.RegisterTranslation("password", Translations{LocaleZh: "{0}不够安全", LocaleEn: "{0} is too weak"}))
+	err = v.TranslateAll(v.V.Struct(_RegisterReq{Password: "a1b2c3"}))
+	require.EqualError(t, err, "password长度不能少于8位")
+	err = v.TranslateAll(v.V.Struct(_RegisterReq{Password: "a1b2c3"}), LocaleEn)
+	require.EqualError(t, err, "password is too weak")
+}
@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// motor vehicle license plate of the people's republic of china, GA 36-2018
+// province abbreviation + issuing authority letter + serial number, I and O are not used in the serial number
+// regular plate: 5 letters or digits, special plates end with 挂 (trailer), 学 (learner), 警 (police),
+// 港 or 澳 (hong kong or macau) and 领 (consulate) instead of the last character
+// new energy plate: 6 letters or digits, D (battery electric) or F (non-battery electric) is at the beginning
+// of the serial number of small vehicles, and at the end of the serial number of large vehicles
+
+// ErrInvalidPlate invalid plate error.
+var ErrInvalidPlate = errors.New("validator: invalid plate")
+
+var (
+	plateRegex = regexp.MustCompile(
+		`^([京津沪渝冀豫云辽黑湘皖鲁新苏浙赣鄂桂甘晋蒙陕吉闽贵粤青藏川宁琼])([A-HJ-NP-Z])` +
+			`(?:([DF][A-HJ-NP-Z0-9][0-9]{4}|[0-9]{5}[DF])|([A-HJ-NP-Z0-9]{5})|([A-HJ-NP-Z0-9]{4})([挂学警港澳领]))$`)
+
+	// plateSeparatorReplacer removes the separators of plate.
+	plateSeparatorReplacer = strings.NewReplacer(" ", "", "·", "", "•", "", "-", "")
+	// plateEnergyTypeMap energy type letter - name map of the new energy plate.
+	plateEnergyTypeMap = map[uint8]string{'D': "纯电动", 'F': "非纯电动"}
+)
+
+// PlateInfo parsed plate information.
+type PlateInfo struct {
+	Province   string // province abbreviation, e.g. 京
+	Authority  string // issuing authority code, e.g. 京A
+	Number     string // serial number, including the special suffix
+	Suffix     string // special suffix, e.g. 挂, 学, empty if absent
+	NewEnergy  bool   // whether it is a new energy plate
+	EnergyType string // energy type of the new energy plate, 纯电动 or 非纯电动, empty if it is not a new energy plate
+}
+
+// String returns the plate in the form of 京A·12345.
+func (pi PlateInfo) String() string {
+	return pi.Authority + "·" + pi.Number
+}
+
+// Plate plate validator.
+type Plate string
+
+// NewPlate new a plate validator.
+func NewPlate(plate string) Plate {
+	return Plate(plate)
+}
+
+// IsValid checks the plate is valid.
+func (p Plate) IsValid() bool {
+	_, err := p.Parse()
+	return err == nil
+}
+
+// IsNewEnergy checks the plate is a valid new energy plate.
+func (p Plate) IsNewEnergy() bool {
+	info, err := p.Parse()
+	return err == nil && info.NewEnergy
+}
+
+// Parse parses the plate, the separators like space and · are ignored.
+func (p Plate) Parse() (*PlateInfo, error) {
+	pStr := plateSeparatorReplacer.Replace(strings.ToUpper(strings.TrimSpace(string(p))))
+	m := plateRegex.FindStringSubmatch(pStr)
+	if m == nil {
+		return nil, ErrInvalidPlate
+	}
+
+	info := &PlateInfo{Province: m[1], Authority: m[1] + m[2]}
+	switch {
+	case m[3] != "":
+		info.Number = m[3]
+		info.NewEnergy = true
+		if energyType, ok := plateEnergyTypeMap[m[3][0]]; ok {
+			info.EnergyType = energyType
+		} else {
+			info.EnergyType = plateEnergyTypeMap[m[3][5]]
+		}
+	case m[4] != "":
+		info.Number = m[4]
+	default:
+		info.Number = m[5] + m[6]
+		info.Suffix = m[6]
+	}
+
+	return info, nil
+}
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlate_Parse(t *testing.T) {
+	cases := []struct {
+		plate  string
+		expect *PlateInfo
+	}{
+		{plate: "京A12345", expect: &PlateInfo{Province: "京", Authority: "京A", Number: "12345"}},
+		{plate: "沪b·8k9z1", expect: &PlateInfo{Province: "沪", Authority: "沪B", Number: "8K9Z1"}},
+		{plate: "粤B D12345", expect: &PlateInfo{
+			Province: "粤", Authority: "粤B", Number: "D12345", NewEnergy: true, EnergyType: "纯电动",
+		}},
+		{plate: "浙AFA1234", expect: &PlateInfo{
+			Province: "浙", Authority: "浙A", Number: "FA1234", NewEnergy: true, EnergyType: "非纯电动",
+		}},
+		{plate: "苏E12345D", expect: &PlateInfo{
+			Province: "苏", Authority: "苏E", Number: "12345D", NewEnergy: true, EnergyType: "纯电动",
+		}},
+		{plate: "鲁A1234挂", expect: &PlateInfo{Province: "鲁", Authority: "鲁A", Number: "1234挂", Suffix: "挂"}},
+		{plate: "粤Z1234港", expect: &PlateInfo{Province: "粤", Authority: "粤Z", Number: "1234港", Suffix: "港"}},
+		{plate: "京AI2345", expect: nil},
+		{plate: "京A1234", expect: nil},
+		{plate: "京A123456", expect: nil},
+		{plate: "A12345", expect: nil},
+		{plate: "港A12345", expect: nil},
+		{plate: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewPlate(c.plate)
+		assert.Equal(t, c.expect != nil, get.IsValid(), c.plate)
+		assert.Equal(t, c.expect != nil && c.expect.NewEnergy, get.IsNewEnergy(), c.plate)
+		info, err := get.Parse()
+		if c.expect != nil {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid plate")
+		}
+		assert.Equal(t, c.expect, info, c.plate)
+	}
+
+	info, err := NewPlate("京A12345").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "京A·12345", info.String())
+}
@@ -0,0 +1,143 @@
+package validator
+
+import (
+	"bufio"
+	_ "embed"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+// administrative division codes of the people's republic of china, GB/T 2260
+// the 6 digits code consists of the province (2 digits), the prefecture (2 digits) and the county (2 digits)
+
+// ErrInvalidRegion invalid region error.
+var ErrInvalidRegion = errors.New("validator: invalid region")
+
+// gb2260Data embedded division code table, one "code name" per line.
+//
+//go:embed data/gb2260.txt
+var gb2260Data string
+
+var (
+	// regionMutex region mutex, used to ensure concurrency security of loading regions.
+	regionMutex sync.RWMutex
+	// regionNameMap code - name map of the division codes.
+	regionNameMap = make(map[string]string)
+
+	// unnamedCityMap names of the prefectures which are only used for grouping counties.
+	unnamedCityMap = map[string]struct{}{
+		"市辖区":         {},
+		"县":           {},
+		"省直辖县级行政区划":   {},
+		"自治区直辖县级行政区划": {},
+	}
+)
+
+func init() {
+	if err := LoadRegions(strings.NewReader(gb2260Data)); err != nil {
+		panic(err)
+	}
+}
+
+// Region administrative division.
+type Region struct {
+	Code     string // 6 digits division code
+	Province string // province name
+	City     string // prefecture name, empty if the code is a province
+	District string // county name, empty if the code is a province or a prefecture, or the county is not in the table
+}
+
+// String returns the full name of the region, e.g. 北京市东城区.
+func (r Region) String() string {
+	city := r.City
+	if _, ok := unnamedCityMap[city]; ok {
+		city = ""
+	}
+
+	return r.Province + city + r.District
+}
+
+// LookupRegion looks up the region by the 6 digits division code,
+// both the current and the historical codes are resolved.
+// The province and the prefecture codes must be in the table, while the county code is only resolved
+// to its prefecture if it is absent from the embedded table, in which case the District is empty,
+// the complete county-level table can be loaded by LoadRegions.
+func LookupRegion(code string) (*Region, error) {
+	if len(code) != 6 || !isDigits(code) || code[2:4] == "00" && code[4:] != "00" {
+		return nil, ErrInvalidRegion
+	}
+
+	regionMutex.RLock()
+	defer regionMutex.RUnlock()
+
+	name, ok := regionNameMap[code]
+	if !ok && code[4:] == "00" {
+		return nil, ErrInvalidRegion
+	}
+
+	r := &Region{Code: code}
+	switch {
+	case code[2:] == "0000":
+		r.Province = name
+		return r, nil
+	case code[4:] == "00":
+		r.City = name
+	default:
+		r.District = name
+		if r.City, ok = regionNameMap[code[:4]+"00"]; !ok {
+			return nil, ErrInvalidRegion
+		}
+	}
+	if r.Province, ok = regionNameMap[code[:2]+"0000"]; !ok {
+		return nil, ErrInvalidRegion
+	}
+
+	return r, nil
+}
+
+// LoadRegions loads the division codes from the reader, one "code name" per line,
+// blank lines and lines starting with # are ignored.
+// The loaded codes are added to the embedded table and override the existing names,
+// so that a complete county-level table can be loaded when needed.
+func LoadRegions(r io.Reader) error {
+	regions := make(map[string]string)
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || len(fields[0]) != 6 || !isDigits(fields[0]) {
+			return errors.New("validator: invalid region line " + line)
+		}
+		regions[fields[0]] = fields[1]
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	regionMutex.Lock()
+	defer regionMutex.Unlock()
+
+	for code, name := range regions {
+		regionNameMap[code] = name
+	}
+
+	return nil
+}
+
+// isDigits reports whether the string consists of decimal digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
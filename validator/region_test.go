@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupRegion(t *testing.T) {
+	cases := []struct {
+		code      string
+		expect    *Region
+		expectErr bool
+	}{
+		{code: "110000", expect: &Region{Code: "110000", Province: "北京市"}},
+		{code: "110108", expect: &Region{Code: "110108", Province: "北京市", City: "市辖区", District: "海淀区"}},
+		{code: "341400", expect: &Region{Code: "341400", Province: "安徽省", City: "巢湖市"}},
+		{code: "510202", expect: &Region{Code: "510202", Province: "四川省", City: "重庆市", District: "市中区"}},
+		{code: "510107", expect: &Region{Code: "510107", Province: "四川省", City: "成都市", District: "武侯区"}},
+		{code: "420106", expect: &Region{Code: "420106", Province: "湖北省", City: "武汉市", District: "武昌区"}},
+		{code: "410105", expect: &Region{Code: "410105", Province: "河南省", City: "郑州市", District: "金水区"}},
+		{code: "440305", expect: &Region{Code: "440305", Province: "广东省", City: "深圳市", District: "南山区"}},
+		// the counties absent from the table are resolved to their prefectures
+		{code: "341421", expect: &Region{Code: "341421", Province: "安徽省", City: "巢湖市"}},
+		{code: "320583", expect: &Region{Code: "320583", Province: "江苏省", City: "苏州市"}},
+		{code: "530102", expect: &Region{Code: "530102", Province: "云南省", City: "昆明市"}},
+		{code: "210102", expect: &Region{Code: "210102", Province: "辽宁省", City: "沈阳市"}},
+		{code: "990000", expectErr: true},
+		{code: "119900", expectErr: true},
+		{code: "119901", expectErr: true},
+		{code: "110001", expectErr: true},
+		{code: "11010", expectErr: true},
+		{code: "11010a", expectErr: true},
+	}
+
+	for _, c := range cases {
+		region, err := LookupRegion(c.code)
+		if c.expectErr {
+			require.ErrorIs(t, err, ErrInvalidRegion, c.code)
+			continue
+		}
+		require.NoError(t, err, c.code)
+		assert.Equal(t, c.expect, region)
+	}
+
+	assert.NoError(t, VerifyVar("110108", "region"))
+	assert.Error(t, VerifyVar("990000", "region"))
+	assert.NoError(t, VerifyVar("510107", "region"))
+	assert.NoError(t, VerifyVar("320583", "region"))
+	assert.Error(t, VerifyVar("119901", "region"))
+}
+
+func TestLoadRegions(t *testing.T) {
+	err := LoadRegions(strings.NewReader("# comment\n\n341422 无为县\n"))
+	require.NoError(t, err)
+	region, err := LookupRegion("341422")
+	require.NoError(t, err)
+	assert.Equal(t, "安徽省巢湖市无为县", region.String())
+
+	err = LoadRegions(strings.NewReader("34142 巢湖\n"))
+	require.Error(t, err)
+	err = LoadRegions(strings.NewReader("341422\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+
+	validator "github.com/go-playground/validator/v10"
+)
+
+// ErrUnsupportedLocale unsupported locale error.
+var ErrUnsupportedLocale = errors.New("validator: unsupported locale")
+
+// Translations locale - translation map of a tag, e.g.
+// Translations{LocaleZh: "{0}必须是有效的SKU", LocaleEn: "{0} must be a valid SKU"},
+// {0} is replaced by the field name and {1} by the parameter of the tag,
+// the locales like zh-TW are normalized to the supported locales.
+type Translations map[string]string
+
+// Default returns the default validator used by Verify, VerifyVar and VerifyVarWithValue,
+// the custom rules registered on it are also available to them.
+func Default() *Validator {
+	return v
+}
+
+// RegisterRule registers the custom validation function of the tag with the translations,
+// the generic translation like {0}校验失败 is used for the locales absent in the translations.
+// The rules should be registered before validating, which is not concurrency safe.
+func (v *Validator) RegisterRule(tag string, fn validator.Func, translations Translations, callValidationEvenIfNull ...bool) error {
+	ts, err := normalizeTranslations(translations)
+	if err != nil {
+		return err
+	}
+	if err := v.V.RegisterValidation(tag, fn, callValidationEvenIfNull...); err != nil {
+		return err
+	}
+
+	return v.registerTranslations(tag, ts, true)
+}
+
+// RegisterAlias registers the alias of the tags with the translations, e.g. the alias
+// tenant_id of the tags required,len=16,alphanum, the generic translation is used for the absent locales.
+func (v *Validator) RegisterAlias(alias, tags string, translations Translations) error {
+	ts, err := normalizeTranslations(translations)
+	if err != nil {
+		return err
+	}
+	v.V.RegisterAlias(alias, tags)
+
+	return v.registerTranslations(alias, ts, true)
+}
+
+// RegisterStructRule registers the struct level validation function of the types with the translations
+// of the tags reported by the function, e.g. sl.ReportError(s.Sku, "sku", "Sku", "sku_stock", "").
+func (v *Validator) RegisterStructRule(fn validator.StructLevelFunc, tagTranslations map[string]Translations, types ...interface{}) error {
+	tss := make(map[string]Translations, len(tagTranslations))
+	for tag, translations := range tagTranslations {
+		ts, err := normalizeTranslations(translations)
+		if err != nil {
+			return err
+		}
+		tss[tag] = ts
+	}
+	v.V.RegisterStructValidation(fn, types...)
+
+	for tag, ts := range tss {
+		if err := v.registerTranslations(tag, ts, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RegisterTranslation overrides the translations of the tag, both the built-in tags like required
+// and the custom tags are supported, the translations of the absent locales are kept.
+func (v *Validator) RegisterTranslation(tag string, translations Translations) error {
+	ts, err := normalizeTranslations(translations)
+	if err != nil {
+		return err
+	}
+
+	for locale, text := range ts {
+		t, _ := v.U.GetTranslator(locale)
+		if err := registerTranslation(tag, text, v.V, t, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerTranslations registers the translations of the tag for all supported locales,
+// the generic translation is used for the locales absent in the translations.
+func (v *Validator) registerTranslations(tag string, translations Translations, override bool) error {
+	for _, lt := range localeTranslators {
+		t, _ := v.U.GetTranslator(lt.locale.Locale())
+		text, ok := translations[t.Locale()]
+		if !ok {
+			text = lt.generic
+		}
+		if err := registerTranslation(tag, text, v.V, t, override); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizeTranslations normalizes the locales of the translations to the supported locales.
+func normalizeTranslations(translations Translations) (Translations, error) {
+	ts := make(Translations, len(translations))
+	for locale, text := range translations {
+		normalized := normalizeLocale(locale)
+		if !isSupportedLocale(normalized) {
+			return nil, fmt.Errorf("%w, %s", ErrUnsupportedLocale, locale)
+		}
+		ts[normalized] = text
+	}
+
+	return ts, nil
+}
+
+// isSupportedLocale reports whether the locale is supported.
+func isSupportedLocale(locale string) bool {
+	for _, lt := range localeTranslators {
+		if lt.locale.Locale() == locale {
+			return true
+		}
+	}
+
+	return false
+}
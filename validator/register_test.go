@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	validator "github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _skuRegex = regexp.MustCompile(`^SKU-[0-9]{6}$`)
+
+type _StockReq struct {
+	Sku      string `json:"sku" validate:"sku"`
+	TenantId string `json:"tenant_id" validate:"tenant_id"`
+	Stock    int    `json:"stock"`
+	Reserved int    `json:"reserved"`
+}
+
+func TestValidator_RegisterRule(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.RegisterRule("sku", func(fl validator.FieldLevel) bool {
+		return _skuRegex.MatchString(fl.Field().String())
+	}, Translations{LocaleZh: "{0}必须是有效的SKU", "en-US": "{0} must be a valid SKU"})
+	require.NoError(t, err)
+
+	err = v.RegisterAlias("tenant_id", "required,len=8,alphanum", Translations{
+		LocaleZh: "{0}必须是有效的租户ID", LocaleEn: "{0} must be a valid tenant id",
+	})
+	require.NoError(t, err)
+
+	err = v.RegisterStructRule(func(sl validator.StructLevel) {
+		req := sl.Current().Interface().(_StockReq)
+		if req.Reserved > req.Stock {
+			sl.ReportError(req.Reserved, "reserved", "Reserved", "lte_stock", "stock")
+		}
+	}, map[string]Translations{
+		"lte_stock": {LocaleZh: "{0}不能大于{1}", LocaleEn: "{0} must not be greater than {1}"},
+	}, _StockReq{})
+	require.NoError(t, err)
+
+	err = v.Translate(v.V.Struct(_StockReq{Sku: "SKU-1", TenantId: "abc", Stock: 1, Reserved: 2}))
+	var ves ValidationErrors
+	require.True(t, errors.As(err, &ves))
+	assert.Equal(t, []string{"sku必须是有效的SKU", "tenant_id必须是有效的租户ID", "reserved不能大于stock"}, ves.Messages())
+	assert.Equal(t, "VALIDATION_TENANT_ID", ves[1].Code)
+
+	err = v.TranslateAll(v.V.Struct(_StockReq{Sku: "SKU-1", TenantId: "abcd1234", Stock: 3, Reserved: 2}), "en")
+	require.EqualError(t, err, "sku must be a valid SKU")
+	err = v.TranslateAll(v.V.Struct(_StockReq{Sku: "SKU-1", TenantId: "abcd1234"}), "zh-TW")
+	require.EqualError(t, err, "sku校驗失敗")
+
+	require.NoError(t, v.V.Struct(_StockReq{Sku: "SKU-123456", TenantId: "abcd1234", Stock: 3, Reserved: 2}))
+
+	err = v.RegisterRule("sku2", func(fl validator.FieldLevel) bool { return true }, Translations{"fr": "{0} invalide"})
+	require.ErrorIs(t, err, ErrUnsupportedLocale)
+	require.Error(t, v.RegisterRule("", func(fl validator.FieldLevel) bool { return true }, nil))
+}
+
+func TestValidator_RegisterTranslation(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	require.NoError(t, v.RegisterTranslation("required", Translations{LocaleZh: "请填写{0}"}))
+	require.NoError(t, v.RegisterTranslation("max", Translations{LocaleEn: "{0} is too long, at most {1}"}))
+
+	err = v.Translate(v.V.Var("", "required"))
+	require.EqualError(t, err, "请填写")
+	err = v.Translate(v.V.Var("", "required"), LocaleEn)
+	require.EqualError(t, err, "is a required field")
+	err = v.Translate(v.V.Var("abcd", "max=3"), LocaleEn)
+	require.EqualError(t, err, "is too long, at most 3")
+
+	require.ErrorIs(t, v.RegisterTranslation("required", Translations{"ja": "{0}"}), ErrUnsupportedLocale)
+	assert.NotNil(t, Default())
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sliveryou/go-tool/v2/timex"
+)
+
+// foreigner permanent residence id card of the people's republic of china
+// 2004 format, 15 characters: country code (ISO 3166-1 alpha-3, 3 letters) + issuing province (2 digits)
+// + birthday (YYMMDD) + sequence (3 digits) + check digit, the check digit is the weighted sum
+// of the first 14 characters with weights 7, 3, 1 modulo 10, and the letters are valued A=10 to Z=35
+// 2023 format, 18 characters: 9 + issuing province (2 digits) + country code (ISO 3166-1 numeric, 3 digits)
+// + birthday (YYYYMMDD) + sequence (3 digits, odd for male and even for female) + check code of GB 11643
+
+// ErrInvalidPermanentResidence invalid permanent residence id card error.
+var ErrInvalidPermanentResidence = errors.New("validator: invalid permanent residence")
+
+var (
+	permanentResidenceOldRegex = regexp.MustCompile(`^[A-Z]{3}[0-9]{12}$`)
+	permanentResidenceRegex    = regexp.MustCompile(`^9[0-9]{16}[0-9X]$`)
+)
+
+// PermanentResidenceInfo parsed permanent residence id card information.
+type PermanentResidenceInfo struct {
+	Format   int       // 2004 or 2023
+	Country  string    // country code, ISO 3166-1 alpha-3 for the 2004 format and numeric for the 2023 format
+	Province string    // issuing province name, empty if the province code is unknown
+	Birthday time.Time // birthday
+	Gender   int       // gender of the 2023 format, Female or Male, -1 for the 2004 format
+}
+
+// PermanentResidence foreigner permanent residence id card validator.
+type PermanentResidence string
+
+// NewPermanentResidence new a foreigner permanent residence id card validator.
+func NewPermanentResidence(card string) PermanentResidence {
+	return PermanentResidence(card)
+}
+
+// IsValid checks the foreigner permanent residence id card is valid.
+func (pr PermanentResidence) IsValid() bool {
+	_, err := pr.Parse()
+	return err == nil
+}
+
+// Parse parses the foreigner permanent residence id card.
+func (pr PermanentResidence) Parse() (*PermanentResidenceInfo, error) {
+	prStr := strings.ToUpper(string(pr))
+
+	var info *PermanentResidenceInfo
+	var birthday string
+	switch {
+	case permanentResidenceOldRegex.MatchString(prStr):
+		if permanentResidenceOldCheckCode(prStr[:14]) != prStr[14] {
+			return nil, ErrInvalidPermanentResidence
+		}
+		info = &PermanentResidenceInfo{Format: 2004, Country: prStr[:3], Gender: -1}
+		info.Province = provinceName(prStr[3:5])
+		// the century is not recorded, the birthday is assumed to be in the past
+		birthday = "20" + prStr[5:11]
+		if birthday > time.Now().In(timex.Shanghai()).Format("20060102") {
+			birthday = "19" + prStr[5:11]
+		}
+	case permanentResidenceRegex.MatchString(prStr):
+		if checkCode(prStr[:17]) != prStr[17] {
+			return nil, ErrInvalidPermanentResidence
+		}
+		info = &PermanentResidenceInfo{Format: 2023, Country: prStr[3:6], Gender: int(prStr[16]-'0') % 2}
+		info.Province = provinceName(prStr[1:3])
+		birthday = prStr[6:14]
+	default:
+		return nil, ErrInvalidPermanentResidence
+	}
+
+	t, err := parseBirthday(birthday)
+	if err != nil || t.Format("20060102") != birthday {
+		return nil, ErrInvalidPermanentResidence
+	}
+	info.Birthday = t
+
+	return info, nil
+}
+
+// permanentResidenceOldCheckCode computes the check digit of the 2004 format permanent residence id card.
+func permanentResidenceOldCheckCode(prStr string) uint8 {
+	weights := [3]int{7, 3, 1}
+
+	sum := 0
+	for index := 0; index < len(prStr); index++ {
+		c := prStr[index]
+		value := int(c - '0')
+		if c >= 'A' {
+			value = int(c-'A') + 10
+		}
+		sum += value * weights[index%3]
+	}
+
+	return uint8('0' + sum%10)
+}
+
+// provinceName returns the province name by the 2 digits province code.
+func provinceName(code string) string {
+	r, err := LookupRegion(code + "0000")
+	if err != nil {
+		return ""
+	}
+
+	return r.Province
+}
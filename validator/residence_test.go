@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermanentResidence_Parse(t *testing.T) {
+	cases := []struct {
+		card           string
+		expect         bool
+		expectFormat   int
+		expectCountry  string
+		expectProvince string
+		expectBirthday string
+		expectGender   int
+	}{
+		{
+			card: "USA32800101017", expect: false,
+		},
+		{
+			card: "USA328001010172", expect: false,
+		},
+		{
+			card: "CAN110513010228", expect: false,
+		},
+		{
+			card: "911840198001010013", expect: true, expectFormat: 2023, expectCountry: "840",
+			expectProvince: "北京市", expectBirthday: "1980-01-01", expectGender: Male,
+		},
+		{
+			card: "944156200102030022", expect: true, expectFormat: 2023, expectCountry: "156",
+			expectProvince: "广东省", expectBirthday: "2001-02-03", expectGender: Female,
+		},
+		{
+			card: "944156200102030023", expect: false,
+		},
+		{
+			card: "911840198002300015", expect: false,
+		},
+		{
+			card: "", expect: false,
+		},
+	}
+
+	for _, c := range cases {
+		get := NewPermanentResidence(c.card)
+		assert.Equal(t, c.expect, get.IsValid(), c.card)
+		info, err := get.Parse()
+		if !c.expect {
+			require.EqualError(t, err, "validator: invalid permanent residence", c.card)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, c.expectFormat, info.Format)
+		assert.Equal(t, c.expectCountry, info.Country)
+		assert.Equal(t, c.expectProvince, info.Province)
+		assert.Equal(t, c.expectBirthday, info.Birthday.Format("2006-01-02"))
+		assert.Equal(t, c.expectGender, info.Gender)
+	}
+
+	info, err := NewPermanentResidence("usa328001010171").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, 2004, info.Format)
+	assert.Equal(t, "USA", info.Country)
+	assert.Equal(t, "江苏省", info.Province)
+	assert.Equal(t, "1980-01-01", info.Birthday.Format("2006-01-02"))
+	assert.Equal(t, -1, info.Gender)
+
+	info, err = NewPermanentResidence("CAN110503010228").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "北京市", info.Province)
+	assert.Equal(t, "2005-03-01", info.Birthday.Format("2006-01-02"))
+}
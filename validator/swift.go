@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// business identifier code (BIC), also known as SWIFT code, ISO 9362
+// bank code (4 letters) + country code (2 letters) + location code (2 letters or digits)
+// + optional branch code (3 letters or digits), XXX represents the primary office,
+// and the location code with the second character 0 represents a test and training code
+
+// ErrInvalidSWIFT invalid swift code error.
+var ErrInvalidSWIFT = errors.New("validator: invalid swift")
+
+var swiftRegex = regexp.MustCompile(`^([A-Z]{4})([A-Z]{2})([A-Z0-9]{2})([A-Z0-9]{3})?$`)
+
+// SWIFTInfo parsed swift code information.
+type SWIFTInfo struct {
+	BankCode     string // 4 letters bank code
+	CountryCode  string // country code, ISO 3166-1 alpha-2
+	LocationCode string // 2 characters location code
+	BranchCode   string // 3 characters branch code, XXX if absent
+	Test         bool   // whether it is a test and training code
+}
+
+// IsPrimary reports whether the swift code represents the primary office.
+func (si SWIFTInfo) IsPrimary() bool {
+	return si.BranchCode == "XXX"
+}
+
+// String returns the swift code in 11 characters, e.g. BKCHCNBJXXX.
+func (si SWIFTInfo) String() string {
+	return si.BankCode + si.CountryCode + si.LocationCode + si.BranchCode
+}
+
+// SWIFT swift code validator.
+type SWIFT string
+
+// NewSWIFT new a swift code validator.
+func NewSWIFT(swift string) SWIFT {
+	return SWIFT(swift)
+}
+
+// IsValid checks the swift code is valid.
+func (s SWIFT) IsValid() bool {
+	_, err := s.Parse()
+	return err == nil
+}
+
+// Parse parses the swift code.
+func (s SWIFT) Parse() (*SWIFTInfo, error) {
+	m := swiftRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(string(s))))
+	if m == nil {
+		return nil, ErrInvalidSWIFT
+	}
+
+	info := &SWIFTInfo{BankCode: m[1], CountryCode: m[2], LocationCode: m[3], BranchCode: m[4], Test: m[3][1] == '0'}
+	if info.BranchCode == "" {
+		info.BranchCode = "XXX"
+	}
+
+	return info, nil
+}
@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSWIFT_Parse(t *testing.T) {
+	cases := []struct {
+		swift  string
+		expect *SWIFTInfo
+	}{
+		{swift: "BKCHCNBJ", expect: &SWIFTInfo{BankCode: "BKCH", CountryCode: "CN", LocationCode: "BJ", BranchCode: "XXX"}},
+		{swift: "bkchcnbj300", expect: &SWIFTInfo{BankCode: "BKCH", CountryCode: "CN", LocationCode: "BJ", BranchCode: "300"}},
+		{swift: "DEUTDEFF500", expect: &SWIFTInfo{BankCode: "DEUT", CountryCode: "DE", LocationCode: "FF", BranchCode: "500"}},
+		{swift: "BKCHCN20", expect: &SWIFTInfo{
+			BankCode: "BKCH", CountryCode: "CN", LocationCode: "20", BranchCode: "XXX", Test: true,
+		}},
+		{swift: "BKCHCNBJ30", expect: nil},
+		{swift: "BKC1CNBJ", expect: nil},
+		{swift: "BKCH12BJ", expect: nil},
+		{swift: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewSWIFT(c.swift)
+		assert.Equal(t, c.expect != nil, get.IsValid(), c.swift)
+		info, err := get.Parse()
+		if c.expect != nil {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid swift")
+		}
+		assert.Equal(t, c.expect, info, c.swift)
+	}
+
+	info, err := NewSWIFT("BKCHCNBJ").Parse()
+	require.NoError(t, err)
+	assert.True(t, info.IsPrimary())
+	assert.Equal(t, "BKCHCNBJXXX", info.String())
+}
@@ -0,0 +1,235 @@
+package validator
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/zh"
+	"github.com/go-playground/locales/zh_Hant"
+	ut "github.com/go-playground/universal-translator"
+	validator "github.com/go-playground/validator/v10"
+	ent "github.com/go-playground/validator/v10/translations/en"
+	zht "github.com/go-playground/validator/v10/translations/zh"
+	zhhantt "github.com/go-playground/validator/v10/translations/zh_tw"
+)
+
+// supported locales.
+const (
+	// LocaleEn english.
+	LocaleEn = "en"
+	// LocaleZh simplified chinese, the default locale.
+	LocaleZh = "zh"
+	// LocaleZhHant traditional chinese.
+	LocaleZhHant = "zh_Hant"
+)
+
+// localeTranslator locale translator and the function to register the default translations.
+type localeTranslator struct {
+	locale   locales.Translator
+	register func(v *validator.Validate, trans ut.Translator) error
+	generic  string // generic translation of the tags without specific translation
+}
+
+// localeTranslators supported locale translators, the first one is the default.
+var localeTranslators = []localeTranslator{
+	{locale: zh.New(), register: zht.RegisterDefaultTranslations, generic: "{0}校验失败"},
+	{locale: en.New(), register: ent.RegisterDefaultTranslations, generic: "{0} is invalid"},
+	{locale: zh_Hant.New(), register: zhhantt.RegisterDefaultTranslations, generic: "{0}校驗失敗"},
+}
+
+// tagTranslationMap tag - locale - translation map of the custom tags.
+var tagTranslationMap = map[string]Translations{
+	"idcard": {
+		LocaleZh: "{0}必须是有效的身份证号", LocaleEn: "{0} must be a valid id card number",
+		LocaleZhHant: "{0}必須是有效的身分證號碼",
+	},
+	"bankcard": {
+		LocaleZh: "{0}必须是有效的银行卡号", LocaleEn: "{0} must be a valid bank card number",
+		LocaleZhHant: "{0}必須是有效的銀行卡號",
+	},
+	"bankcard_debit": {
+		LocaleZh: "{0}必须是有效的借记卡号", LocaleEn: "{0} must be a valid debit card number",
+		LocaleZhHant: "{0}必須是有效的金融卡號",
+	},
+	"uscc": {
+		LocaleZh: "{0}必须是有效的统一社会信用代码", LocaleEn: "{0} must be a valid unified social credit code",
+		LocaleZhHant: "{0}必須是有效的統一社會信用代碼",
+	},
+	"corpaccount": {
+		LocaleZh: "{0}必须是有效的对公账户", LocaleEn: "{0} must be a valid corporate account",
+		LocaleZhHant: "{0}必須是有效的對公帳戶",
+	},
+	"httpmethod": {
+		LocaleZh: "{0}必须是有效的HTTP方法", LocaleEn: "{0} must be a valid HTTP method",
+		LocaleZhHant: "{0}必須是有效的HTTP方法",
+	},
+	"mobile": {
+		LocaleZh: "{0}必须是有效的手机号", LocaleEn: "{0} must be a valid mobile number",
+		LocaleZhHant: "{0}必須是有效的手機號碼",
+	},
+	"landline": {
+		LocaleZh: "{0}必须是有效的固定电话", LocaleEn: "{0} must be a valid landline number",
+		LocaleZhHant: "{0}必須是有效的市話號碼",
+	},
+	"region": {
+		LocaleZh: "{0}必须是有效的行政区划代码", LocaleEn: "{0} must be a valid administrative division code",
+		LocaleZhHant: "{0}必須是有效的行政區劃代碼",
+	},
+	"passport": {
+		LocaleZh: "{0}必须是有效的护照号", LocaleEn: "{0} must be a valid passport number",
+		LocaleZhHant: "{0}必須是有效的護照號碼",
+	},
+	"mainland_travel_permit": {
+		LocaleZh: "{0}必须是有效的港澳台居民来往内地通行证号", LocaleEn: "{0} must be a valid mainland travel permit number",
+		LocaleZhHant: "{0}必須是有效的港澳台居民來往內地通行證號碼",
+	},
+	"permanent_residence": {
+		LocaleZh: "{0}必须是有效的外国人永久居留身份证号", LocaleEn: "{0} must be a valid permanent residence id card number",
+		LocaleZhHant: "{0}必須是有效的外國人永久居留身分證號碼",
+	},
+	"hk_idcard": {
+		LocaleZh: "{0}必须是有效的香港身份证号", LocaleEn: "{0} must be a valid Hong Kong identity card number",
+		LocaleZhHant: "{0}必須是有效的香港身分證號碼",
+	},
+	"macau_idcard": {
+		LocaleZh: "{0}必须是有效的澳门身份证号", LocaleEn: "{0} must be a valid Macau identity card number",
+		LocaleZhHant: "{0}必須是有效的澳門身分證號碼",
+	},
+	"taiwan_idcard": {
+		LocaleZh: "{0}必须是有效的台湾身份证号", LocaleEn: "{0} must be a valid Taiwan identity card number",
+		LocaleZhHant: "{0}必須是有效的臺灣身分證號碼",
+	},
+	"plate": {
+		LocaleZh: "{0}必须是有效的车牌号", LocaleEn: "{0} must be a valid license plate number",
+		LocaleZhHant: "{0}必須是有效的車牌號碼",
+	},
+	"iban": {
+		LocaleZh: "{0}必须是有效的IBAN", LocaleEn: "{0} must be a valid IBAN",
+		LocaleZhHant: "{0}必須是有效的IBAN",
+	},
+	"vin": {
+		LocaleZh: "{0}必须是有效的车辆识别代号", LocaleEn: "{0} must be a valid VIN",
+		LocaleZhHant: "{0}必須是有效的車身號碼",
+	},
+	"isbn_cn": {
+		LocaleZh: "{0}必须是有效的ISBN", LocaleEn: "{0} must be a valid ISBN",
+		LocaleZhHant: "{0}必須是有效的ISBN",
+	},
+	"issn_cn": {
+		LocaleZh: "{0}必须是有效的ISSN", LocaleEn: "{0} must be a valid ISSN",
+		LocaleZhHant: "{0}必須是有效的ISSN",
+	},
+	"gtin": {
+		LocaleZh: "{0}必须是有效的商品条码", LocaleEn: "{0} must be a valid GTIN",
+		LocaleZhHant: "{0}必須是有效的商品條碼",
+	},
+	"swift": {
+		LocaleZh: "{0}必须是有效的SWIFT代码", LocaleEn: "{0} must be a valid SWIFT code",
+		LocaleZhHant: "{0}必須是有效的SWIFT代碼",
+	},
+	"password": {
+		LocaleZh: "{0}强度不足", LocaleEn: "{0} is not strong enough",
+		LocaleZhHant: "{0}強度不足",
+	},
+}
+
+// tagTranslationFuncMap tag - translation function map of the tags whose translations depend on the field,
+// e.g. the chinese translation of password explains the failed rule.
+var tagTranslationFuncMap = map[string]validator.TranslationFunc{
+	"password": passwordTranslationFunc,
+}
+
+// localeContextKey context key of the locale.
+type localeContextKey struct{}
+
+// WithLocale returns a copy of the context with the locale, e.g. en, zh-TW,
+// which takes precedence over the Accept-Language header in Validate.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// localeFromContext gets the locale from the context.
+func localeFromContext(ctx context.Context) (string, bool) {
+	locale, ok := ctx.Value(localeContextKey{}).(string)
+	return locale, ok && locale != ""
+}
+
+// Translator gets the translator of the first supported locale, e.g. en, zh, zh_Hant, zh-TW,
+// the default zh translator is returned if none of the locales is supported.
+func (v *Validator) Translator(locales ...string) ut.Translator {
+	for _, locale := range locales {
+		if t, ok := v.U.GetTranslator(normalizeLocale(locale)); ok {
+			return t
+		}
+	}
+
+	return v.T
+}
+
+// normalizeLocale normalizes the bcp 47 language tag to the supported locale,
+// the chinese used in taiwan, hong kong and macau is treated as traditional chinese.
+func normalizeLocale(locale string) string {
+	tag := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	primary, rest, _ := strings.Cut(tag, "-")
+
+	switch primary {
+	case "en":
+		return LocaleEn
+	case "zh":
+		for _, subtag := range strings.Split(rest, "-") {
+			switch subtag {
+			case "hant", "tw", "hk", "mo":
+				return LocaleZhHant
+			case "hans":
+				return LocaleZh
+			}
+		}
+		return LocaleZh
+	default:
+		return locale
+	}
+}
+
+// parseAcceptLanguage parses the Accept-Language header into the language tags ordered by quality,
+// the wildcard and the tags with quality 0 are ignored.
+func parseAcceptLanguage(header string) []string {
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	var languages []language
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			var err error
+			if quality, err = strconv.ParseFloat(params[2:], 64); err != nil {
+				continue
+			}
+		}
+		if quality > 0 {
+			languages = append(languages, language{tag: tag, quality: quality})
+		}
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	tags := make([]string, 0, len(languages))
+	for _, l := range languages {
+		tags = append(tags, l.tag)
+	}
+
+	return tags
+}
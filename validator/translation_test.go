@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _KYCReq struct {
+	IdCard string `json:"id_card" validate:"idcard"`
+}
+
+func TestValidator_Validate(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	cases := []struct {
+		acceptLanguage string
+		locale         string
+		expect         string
+	}{
+		{acceptLanguage: "", expect: "id_card必须是有效的身份证号"},
+		{acceptLanguage: "en-US,en;q=0.9", expect: "id_card must be a valid id card number"},
+		{acceptLanguage: "fr-FR,zh-TW;q=0.8,en;q=0.5", expect: "id_card必須是有效的身分證號碼"},
+		{acceptLanguage: "en;q=0.5,zh-Hant-HK", expect: "id_card必須是有效的身分證號碼"},
+		{acceptLanguage: "zh-CN,zh;q=0.9", expect: "id_card必须是有效的身份证号"},
+		{acceptLanguage: "fr-FR,*;q=0.5", expect: "id_card必须是有效的身份证号"},
+		{acceptLanguage: "en;q=0,zh-TW", locale: "en", expect: "id_card must be a valid id card number"},
+		{acceptLanguage: "en", locale: "zh_Hant", expect: "id_card必須是有效的身分證號碼"},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest("POST", "/kyc", nil)
+		if c.acceptLanguage != "" {
+			r.Header.Set("Accept-Language", c.acceptLanguage)
+		}
+		if c.locale != "" {
+			r = r.WithContext(WithLocale(r.Context(), c.locale))
+		}
+
+		err := v.Validate(r, &_KYCReq{IdCard: "123"})
+		require.EqualError(t, err, c.expect, c.acceptLanguage)
+	}
+
+	require.NoError(t, v.Validate(nil, &_KYCReq{IdCard: "11010519491231002X"}))
+}
+
+func TestValidator_Translate(t *testing.T) {
+	v, err := NewValidator()
+	require.NoError(t, err)
+
+	err = v.V.Var("abc", "email")
+	require.EqualError(t, v.Translate(err, "en"), "must be a valid email address")
+	require.EqualError(t, v.Translate(err, "zh-HK"), "必須是一個有效的信箱")
+	require.EqualError(t, v.Translate(err, "ja"), "必须是一个有效的邮箱")
+	require.EqualError(t, v.Translate(err), "必须是一个有效的邮箱")
+
+	err = v.V.Var("abc", "boolean")
+	require.EqualError(t, v.TranslateAll(err, "en"), "must be a valid boolean value")
+	err = v.V.Var("abc", "semver")
+	require.EqualError(t, v.TranslateAll(err, "en"), "is invalid")
+	require.EqualError(t, v.TranslateAll(err, "zh_Hant"), "校驗失敗")
+
+	assert.Equal(t, LocaleZhHant, v.Translator("zh-Hant").Locale())
+	assert.Equal(t, LocaleZh, v.Translator("zh-Hans-HK").Locale())
+	assert.Equal(t, LocaleEn, v.Translator("fr", "en-GB").Locale())
+	assert.Equal(t, LocaleZh, v.Translator("fr").Locale())
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, []string{"zh-TW", "en-US", "en"},
+		parseAcceptLanguage("en-US;q=0.8, zh-TW, en;q=0.8, fr;q=0, *;q=0.1, ja;q=abc"))
+	assert.Empty(t, parseAcceptLanguage(""))
+}
@@ -1,6 +1,9 @@
 package validator
 
-import "strings"
+import (
+	"errors"
+	"strings"
+)
 
 // the coding rule of the unified social credit identifier for legal entities and other organizations, GB 32100-2015
 // verification rules: http://openstd.samr.gov.cn/bzgk/gb/newGbInfo?hcno=24691C25985C1073D3A7C85629378AC0
@@ -65,6 +68,50 @@ var usccIndexWeightMap = map[int]int{
 	16: 28,
 }
 
+// ErrInvalidUSCC invalid uscc error.
+var ErrInvalidUSCC = errors.New("validator: invalid uscc")
+
+// registration department code - name map.
+var usccDepartmentMap = map[uint8]string{
+	'1': "机构编制",
+	'2': "外交",
+	'3': "司法行政",
+	'4': "文化",
+	'5': "民政",
+	'6': "旅游",
+	'7': "宗教",
+	'8': "工会",
+	'9': "工商",
+	'A': "中央军委改革和编制办公室",
+	'N': "农业",
+	'Y': "其他",
+}
+
+// registration department code - institution category code - name map.
+var usccCategoryMap = map[uint8]map[uint8]string{
+	'1': {'1': "机关", '2': "事业单位", '3': "中央编办直接管理机构编制的群众团体", '9': "其他"},
+	'2': {'1': "外国常驻新闻机构", '9': "其他"},
+	'3': {'1': "律师执业机构", '2': "公证处", '3': "基层法律服务所", '4': "司法鉴定机构", '5': "仲裁委员会", '9': "其他"},
+	'4': {'1': "外国在华文化中心", '9': "其他"},
+	'5': {'1': "社会团体", '2': "民办非企业单位", '3': "基金会", '9': "其他"},
+	'6': {'1': "外国旅游部门常驻代表机构", '2': "港澳台地区旅游部门常驻内地（大陆）代表机构", '9': "其他"},
+	'7': {'1': "宗教活动场所", '2': "宗教院校", '9': "其他"},
+	'8': {'1': "基层工会", '9': "其他"},
+	'9': {'1': "企业", '2': "个体工商户", '3': "农民专业合作社"},
+	'A': {'1': "军队事业单位", '9': "其他"},
+	'N': {'1': "组级集体经济组织", '2': "村级集体经济组织", '3': "乡镇级集体经济组织", '9': "其他"},
+}
+
+// USCCInfo parsed uscc information.
+type USCCInfo struct {
+	Department   string  // registration department, e.g. 工商
+	Category     string  // institution category, e.g. 企业
+	RegionCode   string  // 6 digits division code of the registration authority
+	Region       *Region // region of the registration authority, nil if the division code is unknown
+	OrgCode      string  // 9 characters organization code, GB 11714
+	OrgCodeValid bool    // whether the organization code passes the GB 11714 check
+}
+
 // USCC uscc validator.
 type USCC string
 
@@ -104,3 +151,74 @@ func (uscc USCC) IsValid() bool {
 
 	return cChar == checkCode
 }
+
+// Parse parses the uscc into the registration department, the institution category,
+// the region and the embedded organization code.
+func (uscc USCC) Parse() (*USCCInfo, error) {
+	if !uscc.IsValid() {
+		return nil, ErrInvalidUSCC
+	}
+
+	usccStr := strings.ToUpper(string(uscc))
+	info := &USCCInfo{
+		Department: usccDepartmentMap[usccStr[0]],
+		Category:   usccCategoryMap[usccStr[0]][usccStr[1]],
+		RegionCode: usccStr[2:8],
+		OrgCode:    usccStr[8:17],
+	}
+	if usccStr[0] == 'Y' {
+		info.Category = "其他"
+	}
+	if info.Department == "" || info.Category == "" {
+		return nil, ErrInvalidUSCC
+	}
+	info.Region, _ = LookupRegion(info.RegionCode)
+	info.OrgCodeValid = NewOrgCode(info.OrgCode).IsValid()
+
+	return info, nil
+}
+
+// organization code for organizations, GB 11714-1997
+// 8 characters body code and 1 check code, C9 = 11 - MOD(∑Ci×Wi, 11), 10 is represented by X and 11 by 0
+
+// orgCodeWeights weights of the organization code body.
+var orgCodeWeights = [8]int{3, 7, 9, 10, 5, 8, 4, 2}
+
+// OrgCode organization code validator.
+type OrgCode string
+
+// NewOrgCode new an organization code validator.
+func NewOrgCode(orgcode string) OrgCode {
+	return OrgCode(orgcode)
+}
+
+// IsValid checks the organization code is valid, the separator before the check code is allowed,
+// e.g. 67062805-9.
+func (oc OrgCode) IsValid() bool {
+	ocStr := strings.ToUpper(strings.Replace(string(oc), "-", "", 1))
+	if !orgcodeRegex.MatchString(ocStr) {
+		return false
+	}
+
+	sum := 0
+	for index := 0; index < 8; index++ {
+		c := ocStr[index]
+		value := int(c - '0')
+		if c >= 'A' {
+			value = int(c-'A') + 10
+		}
+		sum += value * orgCodeWeights[index]
+	}
+
+	var checkCode uint8
+	switch c := 11 - sum%11; c {
+	case 10:
+		checkCode = 'X'
+	case 11:
+		checkCode = '0'
+	default:
+		checkCode = uint8('0' + c)
+	}
+
+	return checkCode == ocStr[8]
+}
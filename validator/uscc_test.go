@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUSCC_IsValid(t *testing.T) {
@@ -23,3 +24,58 @@ func TestUSCC_IsValid(t *testing.T) {
 		assert.Equal(t, c.expect, get.IsValid())
 	}
 }
+
+func TestUSCC_Parse(t *testing.T) {
+	info, err := NewUSCC("91350100MA32C0EA03").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "工商", info.Department)
+	assert.Equal(t, "企业", info.Category)
+	assert.Equal(t, "350100", info.RegionCode)
+	require.NotNil(t, info.Region)
+	assert.Equal(t, "福建省福州市", info.Region.String())
+	assert.Equal(t, "MA32C0EA0", info.OrgCode)
+	assert.True(t, info.OrgCodeValid)
+
+	info, err = NewUSCC("913301086706280599").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "浙江省杭州市滨江区", info.Region.String())
+	assert.Equal(t, "670628059", info.OrgCode)
+	assert.True(t, info.OrgCodeValid)
+
+	info, err = NewUSCC("52110000MJ00000003").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "民政", info.Department)
+	assert.Equal(t, "民办非企业单位", info.Category)
+	assert.Equal(t, "北京市", info.Region.String())
+	assert.False(t, info.OrgCodeValid)
+
+	info, err = NewUSCC("Y1330100MA2AAAAAAL").Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "其他", info.Department)
+	assert.Equal(t, "其他", info.Category)
+
+	_, err = NewUSCC("91110000H12345678T").Parse()
+	require.NoError(t, err)
+	_, err = NewUSCC("91350211M0000XUF46").Parse()
+	require.ErrorIs(t, err, ErrInvalidUSCC)
+}
+
+func TestOrgCode_IsValid(t *testing.T) {
+	cases := []struct {
+		orgcode string
+		expect  bool
+	}{
+		{orgcode: "670628059", expect: true},
+		{orgcode: "67062805-9", expect: true},
+		{orgcode: "MA32C0EA0", expect: true},
+		{orgcode: "ma32c0ea0", expect: true},
+		{orgcode: "670628058", expect: false},
+		{orgcode: "6706280", expect: false},
+		{orgcode: "", expect: false},
+	}
+
+	for _, c := range cases {
+		get := NewOrgCode(c.orgcode)
+		assert.Equal(t, c.expect, get.IsValid(), c.orgcode)
+	}
+}
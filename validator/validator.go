@@ -7,10 +7,9 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/go-playground/locales/zh"
+	"github.com/go-playground/locales"
 	ut "github.com/go-playground/universal-translator"
 	validator "github.com/go-playground/validator/v10"
-	zht "github.com/go-playground/validator/v10/translations/zh"
 )
 
 // ErrUnexpected unexpected error.
@@ -20,7 +19,10 @@ const (
 	bankcardRegexString    = "^[0-9]{15,19}$"
 	corpaccountRegexString = "^[0-9]{9,25}$"
 	idcardRegexString      = "^[0-9]{17}[0-9X]$"
+	idcard15RegexString    = "^[0-9]{15}$"
+	mobileRegexString      = "^1[3-9][0-9]{9}$"
 	usccRegexString        = "^[A-Z0-9]{18}$"
+	orgcodeRegexString     = "^[A-Z0-9]{8}[0-9X]$"
 )
 
 var (
@@ -29,7 +31,10 @@ var (
 	bankcardRegex    = regexp.MustCompile(bankcardRegexString)
 	corpaccountRegex = regexp.MustCompile(corpaccountRegexString)
 	idcardRegex      = regexp.MustCompile(idcardRegexString)
+	idcard15Regex    = regexp.MustCompile(idcard15RegexString)
+	mobileRegex      = regexp.MustCompile(mobileRegexString)
 	usccRegex        = regexp.MustCompile(usccRegexString)
+	orgcodeRegex     = regexp.MustCompile(orgcodeRegexString)
 
 	httpMethodMap = map[string]struct{}{
 		"GET":     {},
@@ -41,11 +46,29 @@ var (
 	}
 
 	validatorFuncMap = map[string]validator.Func{
-		"idcard":      idcard,
-		"bankcard":    bankcard,
-		"uscc":        uscc,
-		"corpaccount": corpaccount,
-		"httpmethod":  httpmethod,
+		"idcard":                 idcard,
+		"bankcard":               bankcard,
+		"bankcard_debit":         bankcardDebit,
+		"uscc":                   uscc,
+		"corpaccount":            corpaccount,
+		"httpmethod":             httpmethod,
+		"mobile":                 mobile,
+		"landline":               landline,
+		"region":                 region,
+		"passport":               passport,
+		"mainland_travel_permit": mainlandTravelPermit,
+		"permanent_residence":    permanentResidence,
+		"hk_idcard":              hkIdCard,
+		"macau_idcard":           macauIdCard,
+		"taiwan_idcard":          taiwanIdCard,
+		"plate":                  plate,
+		"iban":                   iban,
+		"vin":                    vin,
+		"isbn_cn":                isbn,
+		"issn_cn":                issn,
+		"gtin":                   gtin,
+		"swift":                  swift,
+		"password":               password,
 	}
 
 	defaultTags = []string{
@@ -115,45 +138,63 @@ var (
 	}
 )
 
-// Validator represents the validator structure.
+// Validator represents the validator structure,
+// T is the default zh translator and U holds the translators of all supported locales.
 type Validator struct {
 	V *validator.Validate
 	T ut.Translator
+	U *ut.UniversalTranslator
+
+	pathParam PathParamFunc
 }
 
-// NewValidator new a validator.
+// NewValidator new a validator with the en, zh and zh_Hant translators.
 func NewValidator() (*Validator, error) {
 	vi := validator.New()
 	vi.SetTagName("validate")
 	vi.RegisterTagNameFunc(getLabelTagName)
 
-	zhi := zh.New()
-	uti := ut.New(zhi)
-	ti, _ := uti.GetTranslator("zh")
-
-	if err := zht.RegisterDefaultTranslations(vi, ti); err != nil {
-		return nil, err
-	}
-
 	for tag, fn := range validatorFuncMap {
 		if err := vi.RegisterValidation(tag, fn); err != nil {
 			return nil, err
 		}
-		if err := registerTranslation(tag, vi, ti, true); err != nil {
-			return nil, err
-		}
 	}
 
-	for _, defaultTag := range defaultTags {
-		if err := registerTranslation(defaultTag, vi, ti, false); err != nil {
+	lts := make([]locales.Translator, 0, len(localeTranslators))
+	for _, lt := range localeTranslators {
+		lts = append(lts, lt.locale)
+	}
+	uti := ut.New(lts[0], lts...)
+
+	for _, lt := range localeTranslators {
+		ti, _ := uti.GetTranslator(lt.locale.Locale())
+		if err := lt.register(vi, ti); err != nil {
 			return nil, err
 		}
+
+		for _, defaultTag := range defaultTags {
+			// the tags already translated by the default translations are kept
+			var ect *ut.ErrConflictingTranslation
+			if err := registerTranslation(defaultTag, lt.generic, vi, ti, false); err != nil && !errors.As(err, &ect) {
+				return nil, err
+			}
+		}
 	}
 
-	return &Validator{
+	ti, _ := uti.GetTranslator(LocaleZh)
+	v := &Validator{
 		V: vi,
 		T: ti,
-	}, nil
+		U: uti,
+	}
+
+	for tag := range validatorFuncMap {
+		if err := v.registerTranslations(tag, tagTranslationMap[tag], true); err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
 }
 
 // MustNewValidator must new a validator.
@@ -166,78 +207,58 @@ func MustNewValidator() *Validator {
 	return v
 }
 
-// Validate validates the request and parsed data.
+// Validate validates the request and parsed data, the errors are translated to the locale
+// set by WithLocale on the request context, or the preferred locale of the Accept-Language header.
 func (v *Validator) Validate(r *http.Request, data any) error {
-	return v.Translate(v.V.Struct(data))
+	return v.translate(v.V.Struct(data), data, v.Translator(requestLocales(r)...))
 }
 
-// Translate translates the validation errors.
-func (v *Validator) Translate(err error) error {
-	if err != nil {
-		var (
-			es  validateErrors
-			ves validator.ValidationErrors
-		)
+// Translate translates the validation errors to the first supported locale,
+// the default zh translator is used if the locales are absent or not supported.
+// The returned error is ValidationErrors, whose namespaces consist of the struct field names,
+// use Validate or Verify to get the namespaces of the json field names.
+func (v *Validator) Translate(err error, locales ...string) error {
+	return v.translate(err, nil, v.Translator(locales...))
+}
 
-		if errors.As(err, &ves) {
-			for _, ve := range ves {
-				es = append(es, ve.Translate(v.T))
-			}
+// TranslateAll translates all validation errors to the first supported locale.
+func (v *Validator) TranslateAll(err error, locales ...string) error {
+	if err != nil {
+		var ves ValidationErrors
+		if errors.As(v.Translate(err, locales...), &ves) && len(ves) > 0 {
+			return errors.New(strings.Join(ves.Messages(), ","))
 		}
 
-		return es
+		return ErrUnexpected
 	}
 
 	return nil
 }
 
-// TranslateAll translates all validation errors.
-func (v *Validator) TranslateAll(err error) error {
+// translate translates the validation errors of the root data to ValidationErrors.
+func (v *Validator) translate(err error, root interface{}, t ut.Translator) error {
 	if err != nil {
-		var (
-			es  []string
-			ves validator.ValidationErrors
-		)
-
+		var ves validator.ValidationErrors
 		if errors.As(err, &ves) {
-			for _, ve := range ves {
-				es = append(es, ve.Translate(v.T))
-			}
-		}
-
-		if len(es) == 0 {
-			return ErrUnexpected
+			return newValidationErrors(ves, root, t)
 		}
 
-		return errors.New(strings.Join(es, ","))
+		return ValidationErrors{}
 	}
 
 	return nil
 }
 
-// validateErrors represents the validation error strings.
-type validateErrors []string
-
-// Error returns the validation error string and implement the error interface,
-// by default, only the validation error of the first field is returned.
-func (ves validateErrors) Error() string {
-	if len(ves) > 0 {
-		return ves[0]
-	}
-
-	return ErrUnexpected.Error()
-}
-
-// ParseErr parses the content of validation error.
+// ParseErr parses the content of validation error, all validation errors are joined by comma.
 func ParseErr(err error) string {
 	if err == nil {
 		return ""
 	}
 
-	var ves validateErrors
+	var ves ValidationErrors
 	ok := errors.As(err, &ves)
 	if ok && len(ves) > 0 {
-		return strings.Join(ves, ",")
+		return strings.Join(ves.Messages(), ",")
 	}
 
 	return err.Error()
@@ -245,7 +266,7 @@ func ParseErr(err error) string {
 
 // Verify checks the data validity of the exportable field of the structure according to the validate tag.
 func Verify(obj interface{}) error {
-	return v.Translate(v.V.Struct(obj))
+	return v.translate(v.V.Struct(obj), obj, v.T)
 }
 
 // VerifyVar checks the data validity of the field according to the validate tag.
@@ -275,21 +296,25 @@ func getLabelTagName(sf reflect.StructField) string {
 }
 
 // registerTranslation registers translator.
-func registerTranslation(tag string, v *validator.Validate, t ut.Translator, override bool) error {
-	return v.RegisterTranslation(tag, t, registerTranslationsFunc(tag, override), translationFunc(tag))
+func registerTranslation(tag, text string, v *validator.Validate, t ut.Translator, override bool) error {
+	return v.RegisterTranslation(tag, t, registerTranslationsFunc(tag, text, override), translationFunc(tag))
 }
 
 // registerTranslationsFunc register translation function.
-func registerTranslationsFunc(key string, override bool) validator.RegisterTranslationsFunc {
+func registerTranslationsFunc(key, text string, override bool) validator.RegisterTranslationsFunc {
 	return func(ut ut.Translator) error {
-		return ut.Add(key, "{0}校验失败", override)
+		return ut.Add(key, text, override)
 	}
 }
 
-// translationFunc translation function.
+// translationFunc translation function, the tags in tagTranslationFuncMap use their own functions.
 func translationFunc(key string) validator.TranslationFunc {
+	if fn, ok := tagTranslationFuncMap[key]; ok {
+		return fn
+	}
+
 	return func(ut ut.Translator, fe validator.FieldError) string {
-		t, _ := ut.T(key, fe.Field())
+		t, _ := ut.T(key, fe.Field(), fe.Param())
 		return t
 	}
 }
@@ -304,6 +329,17 @@ func bankcard(fl validator.FieldLevel) bool {
 	return NewBankCard(fl.Field().String()).IsValid()
 }
 
+// bankcardDebit represents the debit bank card validator,
+// the cards whose bin is not in the table are only accepted by bankcard_debit=allow_unknown.
+func bankcardDebit(fl validator.FieldLevel) bool {
+	bc := NewBankCard(fl.Field().String())
+	if fl.Param() == bankcardDebitAllowUnknown {
+		return bc.IsDebitOrUnknown()
+	}
+
+	return bc.IsDebit()
+}
+
 // uscc represents the uscc validator.
 func uscc(fl validator.FieldLevel) bool {
 	return NewUSCC(fl.Field().String()).IsValid()
@@ -314,6 +350,89 @@ func corpaccount(fl validator.FieldLevel) bool {
 	return NewCorpAccount(fl.Field().String()).IsValid()
 }
 
+// mobile represents the mobile validator.
+func mobile(fl validator.FieldLevel) bool {
+	return NewMobile(fl.Field().String()).IsValid()
+}
+
+// landline represents the landline validator.
+func landline(fl validator.FieldLevel) bool {
+	return NewLandline(fl.Field().String()).IsValid()
+}
+
+// region represents the administrative division code validator.
+func region(fl validator.FieldLevel) bool {
+	_, err := LookupRegion(fl.Field().String())
+	return err == nil
+}
+
+// passport represents the passport validator.
+func passport(fl validator.FieldLevel) bool {
+	return NewPassport(fl.Field().String()).IsValid()
+}
+
+// mainlandTravelPermit represents the mainland travel permit validator.
+func mainlandTravelPermit(fl validator.FieldLevel) bool {
+	return NewTravelPermit(fl.Field().String()).IsValid()
+}
+
+// permanentResidence represents the foreigner permanent residence id card validator.
+func permanentResidence(fl validator.FieldLevel) bool {
+	return NewPermanentResidence(fl.Field().String()).IsValid()
+}
+
+// hkIdCard represents the hong kong identity card validator.
+func hkIdCard(fl validator.FieldLevel) bool {
+	return NewHKIdCard(fl.Field().String()).IsValid()
+}
+
+// macauIdCard represents the macau identity card validator.
+func macauIdCard(fl validator.FieldLevel) bool {
+	return NewMacauIdCard(fl.Field().String()).IsValid()
+}
+
+// taiwanIdCard represents the taiwan identity card validator.
+func taiwanIdCard(fl validator.FieldLevel) bool {
+	return NewTaiwanIdCard(fl.Field().String()).IsValid()
+}
+
+// plate represents the plate validator.
+func plate(fl validator.FieldLevel) bool {
+	return NewPlate(fl.Field().String()).IsValid()
+}
+
+// iban represents the iban validator.
+func iban(fl validator.FieldLevel) bool {
+	return NewIBAN(fl.Field().String()).IsValid()
+}
+
+// vin represents the vin validator.
+func vin(fl validator.FieldLevel) bool {
+	return NewVIN(fl.Field().String()).IsValid()
+}
+
+// isbn represents the isbn validator, which also accepts the lower case check digit x,
+// it is registered as isbn_cn to keep the built-in isbn validator.
+func isbn(fl validator.FieldLevel) bool {
+	return NewISBN(fl.Field().String()).IsValid()
+}
+
+// issn represents the issn validator, which also accepts the issn without the hyphen
+// and the lower case check digit x, it is registered as issn_cn to keep the built-in issn validator.
+func issn(fl validator.FieldLevel) bool {
+	return NewISSN(fl.Field().String()).IsValid()
+}
+
+// gtin represents the gtin validator.
+func gtin(fl validator.FieldLevel) bool {
+	return NewGTIN(fl.Field().String()).IsValid()
+}
+
+// swift represents the swift code validator.
+func swift(fl validator.FieldLevel) bool {
+	return NewSWIFT(fl.Field().String()).IsValid()
+}
+
 // httpmethod represents the http method validator.
 func httpmethod(fl validator.FieldLevel) bool {
 	_, ok := httpMethodMap[strings.ToUpper(fl.Field().String())]
@@ -38,6 +38,67 @@ func TestVerify(t *testing.T) {
 	require.Error(t, err)
 	t.Log(err, ParseErr(err))
 
+	err = VerifyVar("13800138000", "mobile")
+	require.NoError(t, err)
+	err = VerifyVar("010-87654321", "landline")
+	require.NoError(t, err)
+	err = VerifyVar("12345678901", "mobile")
+	require.EqualError(t, err, "必须是有效的手机号")
+
+	err = VerifyVar("E12345678", "passport")
+	require.NoError(t, err)
+	err = VerifyVar("H12345678", "mainland_travel_permit")
+	require.NoError(t, err)
+	err = VerifyVar("911840198001010013", "permanent_residence")
+	require.NoError(t, err)
+	err = VerifyVar("A123456(3)", "hk_idcard")
+	require.NoError(t, err)
+	err = VerifyVar("1234567(8)", "macau_idcard")
+	require.NoError(t, err)
+	err = VerifyVar("A123456789", "taiwan_idcard")
+	require.NoError(t, err)
+	err = VerifyVar("粤BD12345", "plate")
+	require.NoError(t, err)
+	err = VerifyVar("京AI2345", "plate")
+	require.EqualError(t, err, "必须是有效的车牌号")
+
+	err = VerifyVar("GB82WEST12345698765432", "iban")
+	require.NoError(t, err)
+	err = VerifyVar("LSVAM4187C2184847", "vin")
+	require.NoError(t, err)
+	err = VerifyVar("978-0-306-40615-7", "isbn_cn")
+	require.NoError(t, err)
+	err = VerifyVar("0317-8471", "issn_cn")
+	require.NoError(t, err)
+	err = VerifyVar("0306406153", "isbn_cn")
+	require.EqualError(t, err, "必须是有效的ISBN")
+	err = VerifyVar("0317-8472", "issn_cn")
+	require.EqualError(t, err, "必须是有效的ISSN")
+
+	// the built-in isbn and issn validators are kept
+	for _, c := range []struct {
+		value, tag   string
+		builtin, ext bool
+	}{
+		{value: "9780306406157", tag: "isbn", builtin: true, ext: true},
+		{value: "978 0 306 40615 7", tag: "isbn", builtin: true, ext: true},
+		{value: "0306406153", tag: "isbn", builtin: false, ext: false},
+		{value: "080442957x", tag: "isbn", builtin: false, ext: true},
+		{value: "0317-8471", tag: "issn", builtin: true, ext: true},
+		{value: "03178471", tag: "issn", builtin: false, ext: true},
+		{value: "1000-002x", tag: "issn", builtin: false, ext: true},
+		{value: "0317-8472", tag: "issn", builtin: false, ext: false},
+	} {
+		require.Equal(t, c.builtin, VerifyVar(c.value, c.tag) == nil, c.value)
+		require.Equal(t, c.ext, VerifyVar(c.value, c.tag+"_cn") == nil, c.value)
+	}
+	err = VerifyVar("6901234567892", "gtin")
+	require.NoError(t, err)
+	err = VerifyVar("BKCHCNBJ", "swift")
+	require.NoError(t, err)
+	err = VerifyVar("LSVAM4188C2184847", "vin")
+	require.EqualError(t, err, "必须是有效的车辆识别代号")
+
 	err = VerifyVarWithValue("abcd", "abce", "eqcsfield")
 	require.Error(t, err)
 	t.Log(err, ParseErr(err))
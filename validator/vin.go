@@ -0,0 +1,150 @@
+package validator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sliveryou/go-tool/v2/timex"
+)
+
+// vehicle identification number (VIN), ISO 3779, GB 16735-2019
+// world manufacturer identifier (WMI, 3 characters) + vehicle descriptor section (VDS, 6 characters)
+// + vehicle indicator section (VIS, 8 characters), I, O and Q are not used
+// the 9th character is the check digit, which is the weighted sum of the transliterated values
+// of the characters modulo 11, and 10 is represented by X, it is only required in north america (WMI 1 to 5)
+// and china (WMI L), the other regions may use the 9th character freely
+// the 10th character is the model year, which repeats every 30 years
+
+// ErrInvalidVIN invalid vin error.
+var ErrInvalidVIN = errors.New("validator: invalid vin")
+
+var (
+	vinRegex = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+
+	// vinIndexWeights weights of the vin characters, the check digit itself is weighted 0.
+	vinIndexWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+	// vinCharValueMap transliterated values of the vin letters.
+	vinCharValueMap = map[uint8]int{
+		'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+		'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+		'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+	}
+	// vinYearCodes model year codes, starting from 1980.
+	vinYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+	// vinWMIManufacturerMap wmi - manufacturer map of the common manufacturers.
+	vinWMIManufacturerMap = map[string]string{
+		"1FA": "Ford", "1FT": "Ford", "1G1": "Chevrolet", "1GC": "Chevrolet", "1HG": "Honda",
+		"1N4": "Nissan", "2HG": "Honda", "2T1": "Toyota", "3VW": "Volkswagen", "4T1": "Toyota",
+		"5YJ": "Tesla", "JHM": "Honda", "JN1": "Nissan", "JT2": "Toyota", "JTD": "Toyota",
+		"KMH": "Hyundai", "KNA": "Kia", "SAJ": "Jaguar", "SAL": "Land Rover", "VF1": "Renault",
+		"VF3": "Peugeot", "WAU": "Audi", "WBA": "BMW", "WDB": "Mercedes-Benz", "WDD": "Mercedes-Benz",
+		"WP0": "Porsche", "WVW": "Volkswagen", "YV1": "Volvo", "ZFA": "Fiat", "ZFF": "Ferrari",
+		"LBV": "华晨宝马", "LDC": "神龙汽车", "LE4": "北京奔驰", "LFV": "一汽-大众", "LGB": "东风日产",
+		"LGX": "比亚迪", "LHG": "广汽本田", "LRW": "特斯拉(上海)", "LSG": "上汽通用", "LSV": "上汽大众",
+		"LVG": "广汽丰田", "LVS": "长安福特", "L6T": "吉利汽车",
+	}
+)
+
+// vinRegionRanges the first character ranges of wmi - region.
+var vinRegionRanges = []struct {
+	from, to uint8
+	region   string
+}{
+	{from: '1', to: '5', region: "北美洲"},
+	{from: '6', to: '7', region: "大洋洲"},
+	{from: '8', to: '9', region: "南美洲"},
+	{from: 'A', to: 'H', region: "非洲"},
+	{from: 'J', to: 'R', region: "亚洲"},
+	{from: 'S', to: 'Z', region: "欧洲"},
+}
+
+// VINInfo parsed vin information.
+type VINInfo struct {
+	WMI          string // world manufacturer identifier
+	VDS          string // vehicle descriptor section, including the check digit
+	VIS          string // vehicle indicator section
+	Region       string // region of the manufacturer, e.g. 亚洲
+	Manufacturer string // manufacturer name, empty if the wmi is not in the table
+	ModelYear    int    // model year
+	Plant        string // assembly plant code
+	Serial       string // production serial number
+}
+
+// VIN vin validator.
+type VIN string
+
+// NewVIN new a vin validator.
+func NewVIN(vin string) VIN {
+	return VIN(vin)
+}
+
+// IsValid checks the vin is valid, the check digit is only checked for the vins of north america and china.
+func (v VIN) IsValid() bool {
+	_, err := v.Parse()
+	return err == nil
+}
+
+// Parse parses the vin, the model year is resolved to the latest year
+// which is not later than the next year.
+func (v VIN) Parse() (*VINInfo, error) {
+	vStr := strings.ToUpper(strings.TrimSpace(string(v)))
+	if !vinRegex.MatchString(vStr) {
+		return nil, ErrInvalidVIN
+	}
+
+	if vinCheckDigitRequired(vStr) && vinCheckDigit(vStr) != vStr[8] {
+		return nil, ErrInvalidVIN
+	}
+
+	info := &VINInfo{
+		WMI:          vStr[:3],
+		VDS:          vStr[3:9],
+		VIS:          vStr[9:],
+		Manufacturer: vinWMIManufacturerMap[vStr[:3]],
+		Plant:        vStr[10:11],
+		Serial:       vStr[11:],
+	}
+	for _, r := range vinRegionRanges {
+		if vStr[0] >= r.from && vStr[0] <= r.to {
+			info.Region = r.region
+			break
+		}
+	}
+
+	index := strings.IndexByte(vinYearCodes, vStr[9])
+	if index < 0 {
+		return nil, ErrInvalidVIN
+	}
+	info.ModelYear = 1980 + index
+	for maxYear := time.Now().In(timex.Shanghai()).Year() + 1; info.ModelYear+30 <= maxYear; {
+		info.ModelYear += 30
+	}
+
+	return info, nil
+}
+
+// vinCheckDigitRequired checks the check digit of the vin is required,
+// which is only mandatory in north america and china.
+func vinCheckDigitRequired(vStr string) bool {
+	return (vStr[0] >= '1' && vStr[0] <= '5') || vStr[0] == 'L'
+}
+
+// vinCheckDigit computes the check digit of the vin.
+func vinCheckDigit(vStr string) uint8 {
+	sum := 0
+	for index := 0; index < len(vStr); index++ {
+		value, ok := vinCharValueMap[vStr[index]]
+		if !ok {
+			value = int(vStr[index] - '0')
+		}
+		sum += value * vinIndexWeights[index]
+	}
+	if sum%11 == 10 {
+		return 'X'
+	}
+
+	return uint8('0' + sum%11)
+}
@@ -0,0 +1,49 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVIN_Parse(t *testing.T) {
+	cases := []struct {
+		vin    string
+		expect *VINInfo
+	}{
+		{vin: "LSVAM4187C2184847", expect: &VINInfo{
+			WMI: "LSV", VDS: "AM4187", VIS: "C2184847", Region: "亚洲", Manufacturer: "上汽大众",
+			ModelYear: 2012, Plant: "2", Serial: "184847",
+		}},
+		{vin: "5yj3e1ea7pf123456", expect: &VINInfo{
+			WMI: "5YJ", VDS: "3E1EA7", VIS: "PF123456", Region: "北美洲", Manufacturer: "Tesla",
+			ModelYear: 2023, Plant: "F", Serial: "123456",
+		}},
+		{vin: "1M8GDM9AXKP042788", expect: &VINInfo{
+			WMI: "1M8", VDS: "GDM9AX", VIS: "KP042788", Region: "北美洲",
+			ModelYear: 2019, Plant: "P", Serial: "042788",
+		}},
+		{vin: "WVWZZZ1JZ3W386752", expect: &VINInfo{
+			WMI: "WVW", VDS: "ZZZ1JZ", VIS: "3W386752", Region: "欧洲", Manufacturer: "Volkswagen",
+			ModelYear: 2003, Plant: "W", Serial: "386752",
+		}},
+		{vin: "LSVAM4188C2184847", expect: nil},
+		{vin: "5YJ3E1EA8PF123456", expect: nil},
+		{vin: "LSVAM4187C218484", expect: nil},
+		{vin: "LSVAM4187I2184847", expect: nil},
+		{vin: "", expect: nil},
+	}
+
+	for _, c := range cases {
+		get := NewVIN(c.vin)
+		assert.Equal(t, c.expect != nil, get.IsValid(), c.vin)
+		info, err := get.Parse()
+		if c.expect != nil {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, "validator: invalid vin")
+		}
+		assert.Equal(t, c.expect, info, c.vin)
+	}
+}